@@ -1,6 +1,21 @@
+DROP TABLE IF EXISTS bid_score;
+DROP TABLE IF EXISTS tender_scoring_criterion;
+DROP TABLE IF EXISTS exchange_rate;
+DROP TABLE IF EXISTS job;
+DROP TABLE IF EXISTS saved_search;
+DROP TABLE IF EXISTS email;
+DROP TABLE IF EXISTS notification_preference;
+DROP TABLE IF EXISTS notification;
+DROP TABLE IF EXISTS webhook_delivery;
+DROP TABLE IF EXISTS webhook;
+DROP TABLE IF EXISTS outbox_delivery;
+DROP TABLE IF EXISTS outbox;
+DROP TABLE IF EXISTS audit_log;
+DROP TABLE IF EXISTS bid_status_history;
 DROP TABLE IF EXISTS bid_snapshot;
 DROP TABLE IF EXISTS bid;
 DROP TABLE IF EXISTS decision;
+DROP TABLE IF EXISTS tender_status_history;
 DROP TABLE IF EXISTS tender_snapshot;
 DROP TABLE IF EXISTS tender;
 DROP TABLE IF EXISTS organization_responsible;
@@ -12,8 +27,9 @@ CREATE TABLE IF NOT EXISTS employee (
     username VARCHAR(50) UNIQUE NOT NULL,
     first_name VARCHAR(50),
     last_name VARCHAR(50),
-    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+    email VARCHAR(254),
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
 );
 
 CREATE TABLE IF NOT EXISTS organization (
@@ -21,8 +37,8 @@ CREATE TABLE IF NOT EXISTS organization (
     name VARCHAR(100) NOT NULL,
     description TEXT,
     type VARCHAR(100) NOT NULL,
-    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
 );
 
 CREATE TABLE IF NOT EXISTS organization_responsible (
@@ -39,40 +55,106 @@ CREATE TABLE IF NOT EXISTS tender (
     status VARCHAR(100) NOT NULL,
     organization_id VARCHAR(100),
     version INT DEFAULT 1,
-    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    editor_id VARCHAR(100),
+    edited_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    submission_deadline TIMESTAMPTZ,
+    evaluation_deadline TIMESTAMPTZ,
+    publish_at TIMESTAMPTZ,
+    budget_amount BIGINT CHECK (budget_amount > 0),
+    budget_currency VARCHAR(3),
+    search_vector TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian', name), 'A') ||
+        setweight(to_tsvector('english', name), 'A') ||
+        setweight(to_tsvector('russian', description), 'B') ||
+        setweight(to_tsvector('english', description), 'B')
+    ) STORED
 );
 
+CREATE INDEX IF NOT EXISTS tender_search_vector_idx ON tender USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS tender_created_at_idx ON tender (created_at);
+CREATE INDEX IF NOT EXISTS tender_updated_at_idx ON tender (updated_at);
+CREATE INDEX IF NOT EXISTS tender_submission_deadline_idx ON tender (submission_deadline) WHERE status != 'CLOSED';
+CREATE INDEX IF NOT EXISTS tender_publish_at_idx ON tender (publish_at) WHERE status = 'CREATED';
+
 CREATE TABLE IF NOT EXISTS tender_snapshot (
     id VARCHAR(100),
     tender_id VARCHAR(100),
     name VARCHAR(100) NOT NULL,
     description VARCHAR(500) NOT NULL,
     service_type VARCHAR(100) NOT NULL,
+    budget_amount BIGINT,
+    budget_currency VARCHAR(3),
     version INT DEFAULT 1,
-    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    editor_id VARCHAR(100)
+);
+
+CREATE TABLE IF NOT EXISTS tender_status_history (
+    id VARCHAR(100) PRIMARY KEY,
+    tender_id VARCHAR(100) NOT NULL,
+    from_status VARCHAR(100),
+    to_status VARCHAR(100) NOT NULL,
+    editor_id VARCHAR(100),
+    reason VARCHAR(500),
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
 );
 
+CREATE INDEX IF NOT EXISTS tender_status_history_tender_id_idx ON tender_status_history (tender_id, created_at);
+
 CREATE TABLE IF NOT EXISTS bid (
     id VARCHAR(100) NOT NULL,
     name VARCHAR(100) NOT NULL,
     description VARCHAR(500) NOT NULL,
+    price_amount BIGINT NOT NULL CHECK (price_amount > 0),
+    price_currency VARCHAR(3) NOT NULL,
     status VARCHAR(100) NOT NULL,
     tender_id VARCHAR(100) NOT NULL,
     author_type VARCHAR(100) NOT NULL,
     author_id VARCHAR(100) NOT NULL,
     version INT NOT NULL DEFAULT 1,
-    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    editor_id VARCHAR(100),
+    edited_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    search_vector TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian', name), 'A') ||
+        setweight(to_tsvector('english', name), 'A') ||
+        setweight(to_tsvector('russian', description), 'B') ||
+        setweight(to_tsvector('english', description), 'B')
+    ) STORED
 );
 
+CREATE INDEX IF NOT EXISTS bid_search_vector_idx ON bid USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS bid_created_at_idx ON bid (created_at);
+CREATE INDEX IF NOT EXISTS bid_updated_at_idx ON bid (updated_at);
+CREATE INDEX IF NOT EXISTS bid_tender_id_price_idx ON bid (tender_id, price_amount);
+
 CREATE TABLE IF NOT EXISTS bid_snapshot (
     id VARCHAR(100) NOT NULL,
     bid_id VARCHAR(100) NOT NULL,
     name VARCHAR(100) NOT NULL,
     description VARCHAR(500) NOT NULL,
+    price_amount BIGINT NOT NULL,
+    price_currency VARCHAR(3) NOT NULL,
     version INT NOT NULL DEFAULT 1,
-    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    editor_id VARCHAR(100)
+);
+
+CREATE TABLE IF NOT EXISTS bid_status_history (
+    id VARCHAR(100) PRIMARY KEY,
+    bid_id VARCHAR(100) NOT NULL,
+    from_status VARCHAR(100),
+    to_status VARCHAR(100) NOT NULL,
+    editor_id VARCHAR(100),
+    reason VARCHAR(500),
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
 );
 
+CREATE INDEX IF NOT EXISTS bid_status_history_bid_id_idx ON bid_status_history (bid_id, created_at);
+
 CREATE TABLE IF NOT EXISTS decision (
     id VARCHAR(100) NOT NULL,
     author_id VARCHAR(100) NOT NULL,
@@ -81,12 +163,195 @@ CREATE TABLE IF NOT EXISTS decision (
     status VARCHAR(100)
 );
 
+-- position сохраняет порядок критериев, заданный при их настройке
+CREATE TABLE IF NOT EXISTS tender_scoring_criterion (
+    tender_id VARCHAR(100) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    weight INT NOT NULL CHECK (weight > 0 AND weight <= 100),
+    position INT NOT NULL,
+    PRIMARY KEY (tender_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS bid_score (
+    bid_id VARCHAR(100) NOT NULL,
+    evaluator_id VARCHAR(100) NOT NULL,
+    criterion VARCHAR(100) NOT NULL,
+    score INT NOT NULL CHECK (score >= 0 AND score <= 10),
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (bid_id, evaluator_id, criterion)
+);
+
+CREATE TABLE IF NOT EXISTS audit_log (
+    id VARCHAR(100) PRIMARY KEY,
+    action VARCHAR(100) NOT NULL,
+    actor_id VARCHAR(100) NOT NULL,
+    organization_id VARCHAR(100) NOT NULL,
+    entity_type VARCHAR(100) NOT NULL,
+    entity_id VARCHAR(100) NOT NULL,
+    before JSONB,
+    after JSONB NOT NULL,
+    request_id VARCHAR(100),
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS audit_log_organization_id_idx ON audit_log (organization_id, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS audit_log_entity_idx ON audit_log (entity_type, entity_id);
+
+-- Журнал аудита только пополняется: изменение и удаление записей игнорируются
+CREATE RULE audit_log_no_update AS ON UPDATE TO audit_log DO INSTEAD NOTHING;
+CREATE RULE audit_log_no_delete AS ON DELETE TO audit_log DO INSTEAD NOTHING;
+
+CREATE TABLE IF NOT EXISTS outbox (
+    id VARCHAR(100) PRIMARY KEY,
+    seq BIGSERIAL UNIQUE,
+    stream_seq BIGINT UNIQUE,
+    event_name VARCHAR(100) NOT NULL,
+    aggregate_id VARCHAR(100) NOT NULL,
+    payload JSONB NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    delivered_at TIMESTAMPTZ,
+    last_error TEXT,
+    lock_token VARCHAR(100),
+    dead_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS outbox_pending_idx ON outbox (next_attempt_at, created_at) WHERE delivered_at IS NULL AND dead_at IS NULL;
+-- Сообщения, исчерпавшие попытки доставки, больше не выбираются: SELECT * FROM outbox WHERE dead_at IS NOT NULL
+CREATE INDEX IF NOT EXISTS outbox_dead_idx ON outbox (dead_at) WHERE dead_at IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS outbox_delivery (
+    message_id VARCHAR(100) NOT NULL REFERENCES outbox(id) ON DELETE CASCADE,
+    subscriber VARCHAR(100) NOT NULL,
+    delivered_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (message_id, subscriber)
+);
+
+CREATE TABLE IF NOT EXISTS webhook (
+    id VARCHAR(100) PRIMARY KEY,
+    organization_id VARCHAR(100) NOT NULL REFERENCES organization(id) ON DELETE CASCADE,
+    url VARCHAR(2048) NOT NULL,
+    events TEXT[] NOT NULL,
+    secret VARCHAR(256) NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS webhook_organization_id_idx ON webhook (organization_id);
+
+CREATE TABLE IF NOT EXISTS webhook_delivery (
+    id VARCHAR(100) PRIMARY KEY,
+    webhook_id VARCHAR(100) NOT NULL REFERENCES webhook(id) ON DELETE CASCADE,
+    event_id VARCHAR(100) NOT NULL,
+    event_name VARCHAR(100) NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(100) NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_error TEXT,
+    response_status INT,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    delivered_at TIMESTAMPTZ,
+    lock_token VARCHAR(100),
+    UNIQUE (webhook_id, event_id)
+);
+
+CREATE INDEX IF NOT EXISTS webhook_delivery_pending_idx ON webhook_delivery (next_attempt_at) WHERE status = 'Pending';
+CREATE INDEX IF NOT EXISTS webhook_delivery_webhook_id_idx ON webhook_delivery (webhook_id, created_at DESC);
+
+CREATE TABLE IF NOT EXISTS notification (
+    id VARCHAR(100) PRIMARY KEY,
+    recipient_id VARCHAR(100) NOT NULL REFERENCES employee(id) ON DELETE CASCADE,
+    kind VARCHAR(100) NOT NULL,
+    event_id VARCHAR(100) NOT NULL,
+    tender_id VARCHAR(100) NOT NULL,
+    bid_id VARCHAR(100),
+    message TEXT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    read_at TIMESTAMPTZ,
+    UNIQUE (recipient_id, event_id)
+);
+
+CREATE INDEX IF NOT EXISTS notification_recipient_id_idx ON notification (recipient_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS notification_unread_idx ON notification (recipient_id) WHERE read_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS notification_preference (
+    employee_id VARCHAR(100) PRIMARY KEY REFERENCES employee(id) ON DELETE CASCADE,
+    email_enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    language VARCHAR(10) NOT NULL DEFAULT 'ru',
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS email (
+    id VARCHAR(100) PRIMARY KEY,
+    recipient_id VARCHAR(100) NOT NULL REFERENCES employee(id) ON DELETE CASCADE,
+    event_id VARCHAR(100) NOT NULL,
+    address VARCHAR(254) NOT NULL,
+    subject TEXT NOT NULL,
+    body TEXT NOT NULL,
+    status VARCHAR(100) NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_error TEXT,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    sent_at TIMESTAMPTZ,
+    UNIQUE (recipient_id, event_id)
+);
+
+CREATE INDEX IF NOT EXISTS email_pending_idx ON email (next_attempt_at) WHERE status = 'Pending';
+
+CREATE TABLE IF NOT EXISTS saved_search (
+    id VARCHAR(100) PRIMARY KEY,
+    employee_id VARCHAR(100) NOT NULL REFERENCES employee(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    service_types TEXT[] NOT NULL DEFAULT '{}',
+    organization_ids TEXT[] NOT NULL DEFAULT '{}',
+    query TEXT,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS saved_search_employee_id_idx ON saved_search (employee_id, created_at);
+
+CREATE TABLE IF NOT EXISTS job (
+    id VARCHAR(100) PRIMARY KEY,
+    kind VARCHAR(100) NOT NULL,
+    unique_key VARCHAR(200) UNIQUE,
+    payload JSONB NOT NULL,
+    status VARCHAR(100) NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    max_attempts INT NOT NULL,
+    run_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    locked_until TIMESTAMPTZ,
+    lock_token VARCHAR(100),
+    last_error TEXT,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS job_pending_idx ON job (run_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS job_running_idx ON job (locked_until) WHERE status = 'running';
+
+-- Курс хранится в миллионных долях и действует с effective_date до следующего курса той же пары
+CREATE TABLE IF NOT EXISTS exchange_rate (
+    id VARCHAR(100) PRIMARY KEY,
+    organization_id VARCHAR(100) NOT NULL REFERENCES organization(id) ON DELETE CASCADE,
+    from_currency VARCHAR(3) NOT NULL,
+    to_currency VARCHAR(3) NOT NULL CHECK (to_currency <> from_currency),
+    rate BIGINT NOT NULL CHECK (rate > 0),
+    effective_date DATE NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (organization_id, from_currency, to_currency, effective_date)
+);
+
 -- Insert mock data into employee table
-INSERT INTO employee (id, username, first_name, last_name)
+INSERT INTO employee (id, username, first_name, last_name, email)
 VALUES
-    ('b93e6f53-8c2a-4e32-8a9d-c3498e85cb81', 'user1', 'user1', 'user1'),
-    ('a97e7e41-6c7d-4c6b-9894-019f9f2f021c', 'user2', 'user2', 'user2'),
-    ('f5f8bb13-d799-4d12-8b45-814d3b8ad3e1', 'user3', 'user3', 'user3');
+    ('b93e6f53-8c2a-4e32-8a9d-c3498e85cb81', 'user1', 'user1', 'user1', 'user1@example.com'),
+    ('a97e7e41-6c7d-4c6b-9894-019f9f2f021c', 'user2', 'user2', 'user2', 'user2@example.com'),
+    ('f5f8bb13-d799-4d12-8b45-814d3b8ad3e1', 'user3', 'user3', 'user3', 'user3@example.com');
 
 -- Insert mock data into organization table
 INSERT INTO organization (id, name, description, type)
@@ -7,19 +7,53 @@ import (
 	"os/signal"
 	"syscall"
 	"time"
+	auditrepository "tms/src/core/data/audit-repository"
 	bidrepository "tms/src/core/data/bid-repository"
 	decisionrepository "tms/src/core/data/decision-repository"
+	emailrepository "tms/src/core/data/email-repository"
 	employeerepository "tms/src/core/data/employee-repository"
+	exchangeraterepository "tms/src/core/data/exchange-rate-repository"
+	notificationpreferencerepository "tms/src/core/data/notification-preference-repository"
+	notificationrepository "tms/src/core/data/notification-repository"
 	organizationresponsiblerepository "tms/src/core/data/organization-responsible-repository"
+	outboxrepository "tms/src/core/data/outbox-repository"
+	savedsearchrepository "tms/src/core/data/saved-search-repository"
+	scoringrepository "tms/src/core/data/scoring-repository"
 	tenderrepository "tms/src/core/data/tender-repository"
+	webhookdeliveryrepository "tms/src/core/data/webhook-delivery-repository"
+	webhookrepository "tms/src/core/data/webhook-repository"
+	"tms/src/core/services/audit"
+	"tms/src/core/services/emails"
+	"tms/src/core/services/events"
+	"tms/src/core/services/notifications"
+	"tms/src/core/services/scheduler"
+	"tms/src/core/services/stream"
+	auditusecases "tms/src/core/services/use-cases/audit"
 	bidusecases "tms/src/core/services/use-cases/bid"
+	exchangerateusecases "tms/src/core/services/use-cases/exchange-rate"
+	jobusecases "tms/src/core/services/use-cases/job"
+	notificationusecases "tms/src/core/services/use-cases/notification"
+	savedsearchusecases "tms/src/core/services/use-cases/saved-search"
+	streamusecases "tms/src/core/services/use-cases/stream"
 	usecases "tms/src/core/services/use-cases/tender"
+	webhookusecases "tms/src/core/services/use-cases/webhook"
+	"tms/src/core/services/webhooks"
+	"tms/src/pkg/jobs"
 	"tms/src/pkg/logger/sl"
+	"tms/src/pkg/mailer"
 	"tms/src/pkg/pg"
 	httpserver "tms/src/transport/http-server"
 	"tms/src/transport/http-server/handlers"
+	audithandlers "tms/src/transport/http-server/handlers/audit"
 	bidhandlers "tms/src/transport/http-server/handlers/bid"
+	exchangeratehandlers "tms/src/transport/http-server/handlers/exchange-rate"
+	jobhandlers "tms/src/transport/http-server/handlers/job"
+	notificationhandlers "tms/src/transport/http-server/handlers/notification"
+	savedsearchhandlers "tms/src/transport/http-server/handlers/saved-search"
+	streamhandlers "tms/src/transport/http-server/handlers/stream"
 	tenderhandlers "tms/src/transport/http-server/handlers/tender"
+	webhookhandlers "tms/src/transport/http-server/handlers/webhook"
+	"tms/src/transport/http-server/openapi"
 )
 
 func Run() {
@@ -49,6 +83,115 @@ func Run() {
 	orgResponsibleRepository := organizationresponsiblerepository.New(*psqlClient)
 	bidRepository := bidrepository.New(*psqlClient)
 	decisionRepository := decisionrepository.New(*psqlClient)
+	auditRepository := auditrepository.New(*psqlClient)
+	outboxRepository := outboxrepository.New(*psqlClient)
+	webhookRepository := webhookrepository.New(*psqlClient)
+	webhookDeliveryRepository := webhookdeliveryrepository.New(*psqlClient)
+	notificationRepository := notificationrepository.New(*psqlClient)
+	notificationPreferenceRepository := notificationpreferencerepository.New(*psqlClient)
+	emailRepository := emailrepository.New(*psqlClient)
+	savedSearchRepository := savedsearchrepository.New(*psqlClient)
+	exchangeRateRepository := exchangeraterepository.New(*psqlClient)
+	scoringRepository := scoringrepository.New(*psqlClient)
+
+	// Events
+	eventBus := events.NewBus()
+	eventBus.SubscribeAll("log", events.LogHandler(log))
+	eventBus.SubscribeAll("webhooks", webhooks.Subscriber(webhookRepository, webhookDeliveryRepository, tenderRepository))
+	eventBus.SubscribeAll("notifications", notifications.Subscriber(notificationRepository, tenderRepository, bidRepository, orgResponsibleRepository))
+	eventBus.SubscribeAll("saved-searches", notifications.SavedSearchMatcher(notificationRepository, savedSearchRepository, tenderRepository))
+	eventBus.SubscribeAll("stream", stream.NotifyHandler(outboxRepository, psqlClient))
+	eventHub := stream.NewHub(psqlClient, outboxRepository, stream.NewResolver(tenderRepository, bidRepository), log)
+
+	// События записываются в outbox в транзакции сценария, в eventBus их доставляет relay
+	outboxDispatcher := events.NewOutboxDispatcher(outboxRepository)
+	relay := events.NewRelay(
+		psqlClient,
+		outboxRepository,
+		eventBus,
+		events.RelayConfig{
+			PollInterval: time.Duration(cfg.Outbox.PollInterval) * time.Second,
+			BatchSize:    cfg.Outbox.BatchSize,
+			MaxAttempts:  cfg.Outbox.MaxAttempts,
+			Lease:        time.Duration(cfg.Outbox.Lease) * time.Second,
+			BaseBackoff:  time.Duration(cfg.Outbox.RetryBackoff) * time.Second,
+			MaxBackoff:   time.Duration(cfg.Outbox.MaxBackoff) * time.Second,
+		},
+		log,
+	)
+	webhookSender := webhooks.NewSender(
+		webhookRepository,
+		webhookDeliveryRepository,
+		webhooks.SenderConfig{
+			PollInterval: time.Duration(cfg.Webhook.PollInterval) * time.Second,
+			BatchSize:    cfg.Webhook.BatchSize,
+			MaxAttempts:  cfg.Webhook.MaxAttempts,
+			BaseBackoff:  time.Duration(cfg.Webhook.RetryBackoff) * time.Second,
+			MaxBackoff:   time.Duration(cfg.Webhook.MaxBackoff) * time.Second,
+			Timeout:      time.Duration(cfg.Webhook.Timeout) * time.Second,
+			Lease:        time.Duration(cfg.Webhook.Lease) * time.Second,
+
+			AllowPrivateTargets: cfg.Webhook.AllowPrivateTargets,
+		},
+		log,
+	)
+
+	schedulerConfig := scheduler.Config{
+		PollInterval: time.Duration(cfg.Scheduler.PollInterval) * time.Second,
+		BatchSize:    cfg.Scheduler.BatchSize,
+	}
+	tenderCloser := scheduler.NewTenderCloser(psqlClient, tenderRepository, auditRepository, outboxDispatcher, schedulerConfig, log)
+	tenderPublisher := scheduler.NewTenderPublisher(psqlClient, tenderRepository, auditRepository, outboxDispatcher, schedulerConfig, log)
+
+	jobStore := jobs.NewPostgresStore(psqlClient)
+	jobRunner := jobs.NewRunner(
+		jobStore,
+		jobs.Config{
+			PollInterval: time.Duration(cfg.Jobs.PollInterval) * time.Second,
+			Concurrency:  cfg.Jobs.Concurrency,
+			Lease:        time.Duration(cfg.Jobs.Lease) * time.Second,
+			MaxAttempts:  cfg.Jobs.MaxAttempts,
+			BaseBackoff:  time.Duration(cfg.Jobs.RetryBackoff) * time.Second,
+			MaxBackoff:   time.Duration(cfg.Jobs.MaxBackoff) * time.Second,
+		},
+		log,
+	)
+	tenderCloser.Register(jobRunner)
+	tenderPublisher.Register(jobRunner)
+	webhookSender.Register(jobRunner)
+
+	// Письма отправляются, только если задан SMTP сервер
+	if cfg.Mail.SMTPHost != "" {
+		smtpMailer, err := mailer.NewSMTP(mailer.SMTPConfig{
+			Host:     cfg.Mail.SMTPHost,
+			Port:     cfg.Mail.SMTPPort,
+			Username: cfg.Mail.SMTPUsername,
+			Password: cfg.Mail.SMTPPassword,
+			From:     cfg.Mail.From,
+		})
+		if err != nil {
+			panic(err)
+		}
+
+		eventBus.SubscribeAll("emails", emails.Subscriber(emailRepository, employeeRepository, notificationPreferenceRepository, tenderRepository, bidRepository))
+		emailSender := emails.NewSender(
+			emailRepository,
+			smtpMailer,
+			emails.SenderConfig{
+				PollInterval: time.Duration(cfg.Mail.PollInterval) * time.Second,
+				BatchSize:    cfg.Mail.BatchSize,
+				MaxAttempts:  cfg.Mail.MaxAttempts,
+				BaseBackoff:  time.Duration(cfg.Mail.RetryBackoff) * time.Second,
+				MaxBackoff:   time.Duration(cfg.Mail.MaxBackoff) * time.Second,
+				Timeout:      time.Duration(cfg.Mail.Timeout) * time.Second,
+				Lease:        time.Duration(cfg.Mail.Lease) * time.Second,
+			},
+			log,
+		)
+		emailSender.Register(jobRunner)
+	} else {
+		log.Info("email notifications disabled, MAIL_SMTP_HOST is not set")
+	}
 
 	// UseCases
 	getAllTendersUseCase := usecases.NewGetAllTendersUseCase(tenderRepository)
@@ -56,6 +199,8 @@ func Run() {
 		orgResponsibleRepository,
 		tenderRepository,
 		employeeRepository,
+		psqlClient,
+		outboxDispatcher,
 	)
 	getUserTendersUseCase := usecases.NewGetUserTendersUseCase(
 		employeeRepository,
@@ -71,21 +216,57 @@ func Run() {
 		employeeRepository,
 		orgResponsibleRepository,
 		tenderRepository,
+		psqlClient,
+		outboxDispatcher,
 	)
 	editTenderUseCase := usecases.NewEditTenderUseCase(
 		employeeRepository,
 		orgResponsibleRepository,
 		tenderRepository,
+		psqlClient,
+		outboxDispatcher,
+	)
+	scheduleTenderUseCase := usecases.NewScheduleTenderUseCase(
+		employeeRepository,
+		orgResponsibleRepository,
+		tenderRepository,
+		psqlClient,
+		outboxDispatcher,
 	)
 	rollbackTenderUseCase := usecases.NewRollBackTenderUseCase(
 		employeeRepository,
 		orgResponsibleRepository,
 		tenderRepository,
+		psqlClient,
+		outboxDispatcher,
+	)
+	getTenderStatusHistoryUseCase := usecases.NewGetTenderStatusHistoryUseCase(
+		employeeRepository,
+		orgResponsibleRepository,
+		tenderRepository,
+	)
+	getTenderVersionsUseCase := usecases.NewGetTenderVersionsUseCase(
+		employeeRepository,
+		orgResponsibleRepository,
+		tenderRepository,
+	)
+	getTenderVersionUseCase := usecases.NewGetTenderVersionUseCase(
+		employeeRepository,
+		orgResponsibleRepository,
+		tenderRepository,
+	)
+	getTenderVersionsDiffUseCase := usecases.NewGetTenderVersionsDiffUseCase(
+		employeeRepository,
+		orgResponsibleRepository,
+		tenderRepository,
 	)
 	createBidUseCase := bidusecases.NewCreateBidUseCase(
 		employeeRepository,
 		tenderRepository,
 		bidRepository,
+		exchangeRateRepository,
+		psqlClient,
+		outboxDispatcher,
 	)
 	getUserBidsUseCase := bidusecases.NewGetUserBidsUseCase(
 		employeeRepository,
@@ -96,18 +277,30 @@ func Run() {
 		tenderRepository,
 		bidRepository,
 		orgResponsibleRepository,
+		scoringRepository,
 	)
 	getBidStatusUseCase := bidusecases.NewGetBidStatusUseCase(
 		employeeRepository,
 		bidRepository,
 	)
 	changeBidStatusUseCase := bidusecases.NewChangeBidStatusUseCase(
+		employeeRepository,
+		tenderRepository,
+		bidRepository,
+		psqlClient,
+		outboxDispatcher,
+	)
+	getBidStatusHistoryUseCase := bidusecases.NewGetBidStatusHistoryUseCase(
 		employeeRepository,
 		bidRepository,
 	)
 	editBidUseCase := bidusecases.NewEditBidUseCase(
 		employeeRepository,
+		tenderRepository,
 		bidRepository,
+		exchangeRateRepository,
+		psqlClient,
+		outboxDispatcher,
 	)
 	submitDecisionUseCase := bidusecases.NewSubmitDecisionUseCase(
 		employeeRepository,
@@ -115,60 +308,227 @@ func Run() {
 		bidRepository,
 		tenderRepository,
 		decisionRepository,
+		psqlClient,
+		outboxDispatcher,
 	)
 	rollbackBidUseCase := bidusecases.NewRollbackBidUseCase(
+		employeeRepository,
+		tenderRepository,
+		bidRepository,
+		exchangeRateRepository,
+		psqlClient,
+		outboxDispatcher,
+	)
+	getBidVersionsUseCase := bidusecases.NewGetBidVersionsUseCase(
 		employeeRepository,
 		bidRepository,
 	)
+	getBidVersionUseCase := bidusecases.NewGetBidVersionUseCase(
+		employeeRepository,
+		bidRepository,
+	)
+	getBidVersionsDiffUseCase := bidusecases.NewGetBidVersionsDiffUseCase(
+		employeeRepository,
+		bidRepository,
+	)
+	getTenderCriteriaUseCase := usecases.NewGetTenderCriteriaUseCase(
+		employeeRepository,
+		orgResponsibleRepository,
+		tenderRepository,
+		scoringRepository,
+	)
+	setTenderCriteriaUseCase := usecases.NewSetTenderCriteriaUseCase(
+		employeeRepository,
+		orgResponsibleRepository,
+		tenderRepository,
+		scoringRepository,
+		psqlClient,
+		outboxDispatcher,
+	)
+	submitBidScoresUseCase := bidusecases.NewSubmitBidScoresUseCase(
+		employeeRepository,
+		orgResponsibleRepository,
+		tenderRepository,
+		bidRepository,
+		scoringRepository,
+		psqlClient,
+		outboxDispatcher,
+	)
+
+	// Audit: изменяющие сценарии записываются в журнал аудита в одной транзакции с изменением
+	auditedCreateTenderUseCase := audit.New(&createTenderUseCase, audit.CreateTender(), psqlClient, employeeRepository, auditRepository)
+	auditedChangeTenderStatusUseCase := audit.New(&changeTenderStatusUseCase, audit.ChangeTenderStatus(tenderRepository), psqlClient, employeeRepository, auditRepository)
+	auditedEditTenderUseCase := audit.New(editTenderUseCase, audit.EditTender(tenderRepository), psqlClient, employeeRepository, auditRepository)
+	auditedScheduleTenderUseCase := audit.New(scheduleTenderUseCase, audit.ScheduleTender(tenderRepository), psqlClient, employeeRepository, auditRepository)
+	auditedRollbackTenderUseCase := audit.New(rollbackTenderUseCase, audit.RollbackTender(tenderRepository), psqlClient, employeeRepository, auditRepository)
+	auditedCreateBidUseCase := audit.New(createBidUseCase, audit.CreateBid(tenderRepository), psqlClient, employeeRepository, auditRepository)
+	auditedChangeBidStatusUseCase := audit.New(changeBidStatusUseCase, audit.ChangeBidStatus(bidRepository, tenderRepository), psqlClient, employeeRepository, auditRepository)
+	auditedEditBidUseCase := audit.New(editBidUseCase, audit.EditBid(bidRepository, tenderRepository), psqlClient, employeeRepository, auditRepository)
+	auditedSubmitDecisionUseCase := audit.New(submitDecisionUseCase, audit.SubmitDecision(bidRepository, tenderRepository), psqlClient, employeeRepository, auditRepository)
+	auditedRollbackBidUseCase := audit.New(rollbackBidUseCase, audit.RollbackBid(bidRepository, tenderRepository), psqlClient, employeeRepository, auditRepository)
+	auditedSetTenderCriteriaUseCase := audit.New(setTenderCriteriaUseCase, audit.SetTenderCriteria(tenderRepository, scoringRepository), psqlClient, employeeRepository, auditRepository)
+	auditedSubmitBidScoresUseCase := audit.New(submitBidScoresUseCase, audit.SubmitBidScores(employeeRepository, bidRepository, tenderRepository, scoringRepository), psqlClient, employeeRepository, auditRepository)
+	getAuditLogUseCase := auditusecases.NewGetAuditLogUseCase(
+		employeeRepository,
+		orgResponsibleRepository,
+		auditRepository,
+	)
+	createWebhookUseCase := webhookusecases.NewCreateWebhookUseCase(employeeRepository, orgResponsibleRepository, webhookRepository)
+	getWebhooksUseCase := webhookusecases.NewGetWebhooksUseCase(employeeRepository, orgResponsibleRepository, webhookRepository)
+	editWebhookUseCase := webhookusecases.NewEditWebhookUseCase(employeeRepository, orgResponsibleRepository, webhookRepository)
+	deleteWebhookUseCase := webhookusecases.NewDeleteWebhookUseCase(employeeRepository, orgResponsibleRepository, webhookRepository)
+	getWebhookDeliveriesUseCase := webhookusecases.NewGetWebhookDeliveriesUseCase(employeeRepository, orgResponsibleRepository, webhookRepository, webhookDeliveryRepository)
+	redeliverWebhookUseCase := webhookusecases.NewRedeliverWebhookUseCase(employeeRepository, orgResponsibleRepository, webhookRepository, webhookDeliveryRepository)
+	auditedCreateWebhookUseCase := audit.New(createWebhookUseCase, audit.CreateWebhook(), psqlClient, employeeRepository, auditRepository)
+	auditedEditWebhookUseCase := audit.New(editWebhookUseCase, audit.EditWebhook(webhookRepository), psqlClient, employeeRepository, auditRepository)
+	auditedDeleteWebhookUseCase := audit.New(deleteWebhookUseCase, audit.DeleteWebhook(webhookRepository), psqlClient, employeeRepository, auditRepository)
+	getNotificationsUseCase := notificationusecases.NewGetNotificationsUseCase(employeeRepository, notificationRepository)
+	getUnreadNotificationsCountUseCase := notificationusecases.NewGetUnreadNotificationsCountUseCase(employeeRepository, notificationRepository)
+	readNotificationUseCase := notificationusecases.NewReadNotificationUseCase(employeeRepository, notificationRepository)
+	readAllNotificationsUseCase := notificationusecases.NewReadAllNotificationsUseCase(employeeRepository, notificationRepository)
+	getNotificationPreferencesUseCase := notificationusecases.NewGetNotificationPreferencesUseCase(employeeRepository, notificationPreferenceRepository)
+	editNotificationPreferencesUseCase := notificationusecases.NewEditNotificationPreferencesUseCase(employeeRepository, notificationPreferenceRepository)
+	getSavedSearchesUseCase := savedsearchusecases.NewGetSavedSearchesUseCase(employeeRepository, savedSearchRepository)
+	createSavedSearchUseCase := savedsearchusecases.NewCreateSavedSearchUseCase(employeeRepository, savedSearchRepository)
+	editSavedSearchUseCase := savedsearchusecases.NewEditSavedSearchUseCase(employeeRepository, savedSearchRepository)
+	deleteSavedSearchUseCase := savedsearchusecases.NewDeleteSavedSearchUseCase(employeeRepository, savedSearchRepository)
+	auditedCreateSavedSearchUseCase := audit.New(createSavedSearchUseCase, audit.CreateSavedSearch(orgResponsibleRepository), psqlClient, employeeRepository, auditRepository)
+	auditedEditSavedSearchUseCase := audit.New(editSavedSearchUseCase, audit.EditSavedSearch(savedSearchRepository, orgResponsibleRepository), psqlClient, employeeRepository, auditRepository)
+	auditedDeleteSavedSearchUseCase := audit.New(deleteSavedSearchUseCase, audit.DeleteSavedSearch(savedSearchRepository, orgResponsibleRepository), psqlClient, employeeRepository, auditRepository)
+	getJobsUseCase := jobusecases.NewGetJobsUseCase(employeeRepository, jobStore, cfg.Jobs.Admins)
+	getExchangeRatesUseCase := exchangerateusecases.NewGetExchangeRatesUseCase(employeeRepository, exchangeRateRepository)
+	createExchangeRateUseCase := exchangerateusecases.NewCreateExchangeRateUseCase(employeeRepository, orgResponsibleRepository, exchangeRateRepository)
+	deleteExchangeRateUseCase := exchangerateusecases.NewDeleteExchangeRateUseCase(employeeRepository, orgResponsibleRepository, exchangeRateRepository)
+	auditedCreateExchangeRateUseCase := audit.New(createExchangeRateUseCase, audit.CreateExchangeRate(), psqlClient, employeeRepository, auditRepository)
+	auditedDeleteExchangeRateUseCase := audit.New(deleteExchangeRateUseCase, audit.DeleteExchangeRate(exchangeRateRepository), psqlClient, employeeRepository, auditRepository)
+	subscribeUseCase := streamusecases.NewSubscribeUseCase(employeeRepository, orgResponsibleRepository, eventHub)
+	subscribeTenderUseCase := streamusecases.NewSubscribeTenderUseCase(employeeRepository, orgResponsibleRepository, tenderRepository, eventHub)
 
 	// Handlers
+	spec := openapi.MustLoad()
 	pingHandler := handlers.NewPingHandler()
+	openAPIHandler := handlers.NewOpenAPIHandler(*log, spec)
+	docsHandler := handlers.NewDocsHandler()
 	getAllTendersHandler := tenderhandlers.NewGetAllTendersHandler(*log, getAllTendersUseCase)
-	createTenderHandler := tenderhandlers.NewCreateTenderHandler(*log, createTenderUseCase)
+	createTenderHandler := tenderhandlers.NewCreateTenderHandler(*log, auditedCreateTenderUseCase)
 	getMyTendersHandler := tenderhandlers.NewGetMyTendersHandlers(*log, getUserTendersUseCase)
 	getTenderStatusHandler := tenderhandlers.NewGetTenderStatus(*log, getTenderStatusUseCase)
-	changeTenderStatusHandler := tenderhandlers.NewChangeTenderStatusHandler(*log, changeTenderStatusUseCase)
-	editTenderUseHandler := tenderhandlers.NewEditTenderHandler(*log, editTenderUseCase)
-	rollbackTenderHandler := tenderhandlers.NewRollbackTenderHandler(*log, rollbackTenderUseCase)
-	createBidHandler := bidhandlers.NewCreateBidHandler(*log, createBidUseCase)
+	changeTenderStatusHandler := tenderhandlers.NewChangeTenderStatusHandler(*log, auditedChangeTenderStatusUseCase)
+	getTenderStatusHistoryHandler := tenderhandlers.NewGetTenderStatusHistoryHandler(*log, getTenderStatusHistoryUseCase)
+	editTenderUseHandler := tenderhandlers.NewEditTenderHandler(*log, auditedEditTenderUseCase)
+	scheduleTenderHandler := tenderhandlers.NewScheduleTenderHandler(*log, auditedScheduleTenderUseCase)
+	getTenderCriteriaHandler := tenderhandlers.NewGetTenderCriteriaHandler(*log, getTenderCriteriaUseCase)
+	setTenderCriteriaHandler := tenderhandlers.NewSetTenderCriteriaHandler(*log, auditedSetTenderCriteriaUseCase)
+	rollbackTenderHandler := tenderhandlers.NewRollbackTenderHandler(*log, auditedRollbackTenderUseCase)
+	getTenderVersionsHandler := tenderhandlers.NewGetTenderVersionsHandler(*log, getTenderVersionsUseCase)
+	getTenderVersionHandler := tenderhandlers.NewGetTenderVersionHandler(*log, getTenderVersionUseCase)
+	getTenderDiffHandler := tenderhandlers.NewGetTenderVersionsDiffHandler(*log, getTenderVersionsDiffUseCase)
+	createBidHandler := bidhandlers.NewCreateBidHandler(*log, auditedCreateBidUseCase)
 	getUserBidsHandler := bidhandlers.NewGetUserBidsHandler(*log, getUserBidsUseCase)
 	getBidsOfTenderHandler := bidhandlers.NewGetBidsOfTender(*log, getBidsOfTenderUseCase)
 	getBidStatusHandler := bidhandlers.NewGetBidStatusHandler(*log, getBidStatusUseCase)
-	changeBidStatusHandler := bidhandlers.NewChangeBidStatusHandler(*log, changeBidStatusUseCase)
-	editBidHandler := bidhandlers.NewEditBidHandler(*log, editBidUseCase)
-	submitDecisionHandler := bidhandlers.NewSubmitDecisionHandler(*log, submitDecisionUseCase)
-	rollbackBidHandler := bidhandlers.NewRollBackHandler(*log, rollbackBidUseCase)
+	changeBidStatusHandler := bidhandlers.NewChangeBidStatusHandler(*log, auditedChangeBidStatusUseCase)
+	getBidStatusHistoryHandler := bidhandlers.NewGetBidStatusHistoryHandler(*log, getBidStatusHistoryUseCase)
+	editBidHandler := bidhandlers.NewEditBidHandler(*log, auditedEditBidUseCase)
+	submitDecisionHandler := bidhandlers.NewSubmitDecisionHandler(*log, auditedSubmitDecisionUseCase)
+	submitBidScoresHandler := bidhandlers.NewSubmitBidScoresHandler(*log, auditedSubmitBidScoresUseCase)
+	rollbackBidHandler := bidhandlers.NewRollBackHandler(*log, auditedRollbackBidUseCase)
+	getBidVersionsHandler := bidhandlers.NewGetBidVersionsHandler(*log, getBidVersionsUseCase)
+	getBidVersionHandler := bidhandlers.NewGetBidVersionHandler(*log, getBidVersionUseCase)
+	getBidDiffHandler := bidhandlers.NewGetBidVersionsDiffHandler(*log, getBidVersionsDiffUseCase)
+	getAuditLogHandler := audithandlers.NewGetAuditLogHandler(*log, getAuditLogUseCase)
+	getWebhooksHandler := webhookhandlers.NewGetWebhooksHandler(*log, getWebhooksUseCase)
+	createWebhookHandler := webhookhandlers.NewCreateWebhookHandler(*log, auditedCreateWebhookUseCase)
+	editWebhookHandler := webhookhandlers.NewEditWebhookHandler(*log, auditedEditWebhookUseCase)
+	deleteWebhookHandler := webhookhandlers.NewDeleteWebhookHandler(*log, auditedDeleteWebhookUseCase)
+	getWebhookDeliveriesHandler := webhookhandlers.NewGetWebhookDeliveriesHandler(*log, getWebhookDeliveriesUseCase)
+	redeliverWebhookHandler := webhookhandlers.NewRedeliverWebhookHandler(*log, redeliverWebhookUseCase)
+	getNotificationsHandler := notificationhandlers.NewGetNotificationsHandler(*log, getNotificationsUseCase)
+	getUnreadNotificationsCountHandler := notificationhandlers.NewGetUnreadNotificationsCountHandler(*log, getUnreadNotificationsCountUseCase)
+	readNotificationHandler := notificationhandlers.NewReadNotificationHandler(*log, readNotificationUseCase)
+	readAllNotificationsHandler := notificationhandlers.NewReadAllNotificationsHandler(*log, readAllNotificationsUseCase)
+	getNotificationPreferencesHandler := notificationhandlers.NewGetNotificationPreferencesHandler(*log, getNotificationPreferencesUseCase)
+	editNotificationPreferencesHandler := notificationhandlers.NewEditNotificationPreferencesHandler(*log, editNotificationPreferencesUseCase)
+	getJobsHandler := jobhandlers.NewGetJobsHandler(*log, getJobsUseCase)
+	getExchangeRatesHandler := exchangeratehandlers.NewGetExchangeRatesHandler(*log, getExchangeRatesUseCase)
+	createExchangeRateHandler := exchangeratehandlers.NewCreateExchangeRateHandler(*log, auditedCreateExchangeRateUseCase)
+	deleteExchangeRateHandler := exchangeratehandlers.NewDeleteExchangeRateHandler(*log, auditedDeleteExchangeRateUseCase)
+	getSavedSearchesHandler := savedsearchhandlers.NewGetSavedSearchesHandler(*log, getSavedSearchesUseCase)
+	createSavedSearchHandler := savedsearchhandlers.NewCreateSavedSearchHandler(*log, auditedCreateSavedSearchUseCase)
+	editSavedSearchHandler := savedsearchhandlers.NewEditSavedSearchHandler(*log, auditedEditSavedSearchUseCase)
+	deleteSavedSearchHandler := savedsearchhandlers.NewDeleteSavedSearchHandler(*log, auditedDeleteSavedSearchUseCase)
+	streamEventsHandler := streamhandlers.NewStreamEventsHandler(*log, subscribeUseCase)
+	liveBidsOfTenderHandler := streamhandlers.NewTenderBidsSocketHandler(*log, subscribeTenderUseCase)
 
 	done := make(chan os.Signal, 1)
 	signal.Notify(done, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
 
 	h := httpserver.Handlers{
-		Ping:               pingHandler,
-		GetAllTenders:      getAllTendersHandler,
-		CreateTenders:      createTenderHandler,
-		GetMyTenders:       getMyTendersHandler,
-		GetTenderStatus:    getTenderStatusHandler,
-		ChangeTenderStatus: changeTenderStatusHandler,
-		EditTender:         editTenderUseHandler,
-		RollbackTender:     rollbackTenderHandler,
-		CreateBid:          createBidHandler,
-		GetUserBid:         getUserBidsHandler,
-		GetBidsOfTender:    getBidsOfTenderHandler,
-		GetBidStatus:       getBidStatusHandler,
-		ChangeBidStatus:    changeBidStatusHandler,
-		EditBid:            editBidHandler,
-		SubmitDecision:     submitDecisionHandler,
-		RollbackBid:        rollbackBidHandler,
+		Ping:                        pingHandler,
+		OpenAPI:                     openAPIHandler,
+		Docs:                        docsHandler,
+		GetAllTenders:               getAllTendersHandler,
+		CreateTenders:               createTenderHandler,
+		GetMyTenders:                getMyTendersHandler,
+		GetTenderStatus:             getTenderStatusHandler,
+		ChangeTenderStatus:          changeTenderStatusHandler,
+		GetTenderStatusHistory:      getTenderStatusHistoryHandler,
+		EditTender:                  editTenderUseHandler,
+		ScheduleTender:              scheduleTenderHandler,
+		GetTenderCriteria:           getTenderCriteriaHandler,
+		SetTenderCriteria:           setTenderCriteriaHandler,
+		RollbackTender:              rollbackTenderHandler,
+		GetTenderVersions:           getTenderVersionsHandler,
+		GetTenderVersion:            getTenderVersionHandler,
+		GetTenderDiff:               getTenderDiffHandler,
+		CreateBid:                   createBidHandler,
+		GetUserBid:                  getUserBidsHandler,
+		GetBidsOfTender:             getBidsOfTenderHandler,
+		LiveBidsOfTender:            liveBidsOfTenderHandler,
+		GetBidStatus:                getBidStatusHandler,
+		ChangeBidStatus:             changeBidStatusHandler,
+		GetBidStatusHistory:         getBidStatusHistoryHandler,
+		EditBid:                     editBidHandler,
+		SubmitDecision:              submitDecisionHandler,
+		SubmitBidScores:             submitBidScoresHandler,
+		RollbackBid:                 rollbackBidHandler,
+		GetBidVersions:              getBidVersionsHandler,
+		GetBidVersion:               getBidVersionHandler,
+		GetBidDiff:                  getBidDiffHandler,
+		GetAuditLog:                 getAuditLogHandler,
+		GetWebhooks:                 getWebhooksHandler,
+		CreateWebhook:               createWebhookHandler,
+		EditWebhook:                 editWebhookHandler,
+		DeleteWebhook:               deleteWebhookHandler,
+		GetWebhookDeliveries:        getWebhookDeliveriesHandler,
+		RedeliverWebhook:            redeliverWebhookHandler,
+		GetNotifications:            getNotificationsHandler,
+		GetUnreadNotificationsCount: getUnreadNotificationsCountHandler,
+		ReadNotification:            readNotificationHandler,
+		ReadAllNotifications:        readAllNotificationsHandler,
+		GetNotificationPreferences:  getNotificationPreferencesHandler,
+		EditNotificationPreferences: editNotificationPreferencesHandler,
+		GetSavedSearches:            getSavedSearchesHandler,
+		CreateSavedSearch:           createSavedSearchHandler,
+		EditSavedSearch:             editSavedSearchHandler,
+		DeleteSavedSearch:           deleteSavedSearchHandler,
+		GetJobs:                     getJobsHandler,
+		GetExchangeRates:            getExchangeRatesHandler,
+		CreateExchangeRate:          createExchangeRateHandler,
+		DeleteExchangeRate:          deleteExchangeRateHandler,
+		StreamEvents:                streamEventsHandler,
 	}
 
 	srv := httpserver.New(
 		h,
 		*log,
 		httpserver.Config{
-			Address:      cfg.HTTPServer.Address,
-			ReadTimeout:  cfg.HTTPServer.ReadTimeout,
-			WriteTimeout: cfg.HTTPServer.WriteTimeout,
-			IdleTimeout:  cfg.HTTPServer.IdleTimeout,
+			Address:           cfg.HTTPServer.Address,
+			ReadTimeout:       cfg.HTTPServer.ReadTimeout,
+			WriteTimeout:      cfg.HTTPServer.WriteTimeout,
+			IdleTimeout:       cfg.HTTPServer.IdleTimeout,
+			Spec:              spec,
+			ValidateResponses: cfg.HTTPServer.ValidateResponses,
 		},
 	)
 
@@ -178,13 +538,31 @@ func Run() {
 		}
 	}()
 
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	go relay.Run(workersCtx)
+	go eventHub.Run(workersCtx)
+	go jobRunner.Run(workersCtx)
+
 	<-done
 
+	stopWorkers()
+
 	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(10)*time.Second)
 	defer cancel()
 
-	if err := srv.Shutdown(ctx); err != nil {
-		log.Error("failed to stop server", sl.Err(err))
+	// Выполняемые фоновые задачи дорабатывают параллельно с открытыми запросами,
+	// а не завершенные до истечения ctx возвращаются в очередь
+	jobsStopped := make(chan error, 1)
+	go func() {
+		jobsStopped <- jobRunner.Shutdown(ctx)
+	}()
+
+	srvErr := srv.Shutdown(ctx)
+	if err := <-jobsStopped; err != nil {
+		log.Error("background jobs were interrupted", sl.Err(err))
+	}
+	if srvErr != nil {
+		log.Error("failed to stop server", sl.Err(srvErr))
 		return
 	}
 
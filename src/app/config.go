@@ -4,6 +4,7 @@ import (
 	"github.com/ilyakaznacheev/cleanenv"
 	"github.com/joho/godotenv"
 	"log/slog"
+	"tms/src/pkg/logger/sl"
 )
 
 type HTTPServerConfig struct {
@@ -11,6 +12,8 @@ type HTTPServerConfig struct {
 	ReadTimeout  uint   `env:"SERVICE_READ_TIMEOUT" env-default:"30"`
 	WriteTimeout uint   `env:"SERVICE_WRITE_TIMEOUT" env-default:"30"`
 	IdleTimeout  uint   `env:"SERVICE_IDLE_TIMEOUT" env-default:"30"`
+	// Проверка ответов по OpenAPI спецификации, нарушения пишутся в лог
+	ValidateResponses bool `env:"SERVICE_VALIDATE_RESPONSES" env-default:"false"`
 }
 
 type Postgres struct {
@@ -19,9 +22,66 @@ type Postgres struct {
 	Migration   string `env:"POSTGRES_MIGRATION"`
 }
 
+type OutboxConfig struct {
+	PollInterval uint `env:"OUTBOX_POLL_INTERVAL" env-default:"1"` // Период опроса outbox в секундах
+	BatchSize    int  `env:"OUTBOX_BATCH_SIZE" env-default:"100"`
+	MaxAttempts  int  `env:"OUTBOX_MAX_ATTEMPTS" env-default:"10"`
+	Lease        uint `env:"OUTBOX_LEASE" env-default:"60"` // Время в секундах, на которое выбранные сообщения скрываются от других экземпляров
+	RetryBackoff uint `env:"OUTBOX_RETRY_BACKOFF" env-default:"1"`
+	MaxBackoff   uint `env:"OUTBOX_MAX_BACKOFF" env-default:"300"`
+}
+
+type WebhookConfig struct {
+	PollInterval uint `env:"WEBHOOK_POLL_INTERVAL" env-default:"1"` // Период опроса очереди доставок в секундах
+	BatchSize    int  `env:"WEBHOOK_BATCH_SIZE" env-default:"10"`
+	MaxAttempts  int  `env:"WEBHOOK_MAX_ATTEMPTS" env-default:"8"`
+	RetryBackoff uint `env:"WEBHOOK_RETRY_BACKOFF" env-default:"5"`
+	MaxBackoff   uint `env:"WEBHOOK_MAX_BACKOFF" env-default:"3600"`
+	Timeout      uint `env:"WEBHOOK_TIMEOUT" env-default:"10"`
+	Lease        uint `env:"WEBHOOK_LEASE" env-default:"300"` // Время в секундах, на которое отправитель забирает пачку доставок
+
+	AllowPrivateTargets bool `env:"WEBHOOK_ALLOW_PRIVATE_TARGETS" env-default:"false"` // Разрешить вебхуки на внутренние адреса
+}
+
+type MailConfig struct {
+	SMTPHost     string `env:"MAIL_SMTP_HOST"` // Адрес SMTP сервера, если не задан, письма не отправляются
+	SMTPPort     int    `env:"MAIL_SMTP_PORT" env-default:"587"`
+	SMTPUsername string `env:"MAIL_SMTP_USERNAME"`
+	SMTPPassword string `env:"MAIL_SMTP_PASSWORD"`
+	From         string `env:"MAIL_FROM" env-default:"TMS <noreply@example.com>"`
+	PollInterval uint   `env:"MAIL_POLL_INTERVAL" env-default:"5"` // Период опроса очереди писем в секундах
+	BatchSize    int    `env:"MAIL_BATCH_SIZE" env-default:"20"`
+	MaxAttempts  int    `env:"MAIL_MAX_ATTEMPTS" env-default:"5"`
+	RetryBackoff uint   `env:"MAIL_RETRY_BACKOFF" env-default:"30"`
+	MaxBackoff   uint   `env:"MAIL_MAX_BACKOFF" env-default:"3600"`
+	Timeout      uint   `env:"MAIL_TIMEOUT" env-default:"30"`
+	Lease        uint   `env:"MAIL_LEASE" env-default:"900"` // Время в секундах, на которое отправитель забирает пачку писем
+}
+
+type SchedulerConfig struct {
+	PollInterval uint `env:"SCHEDULER_POLL_INTERVAL" env-default:"10"` // Период поиска тендеров для автоматического закрытия и публикации в секундах
+	BatchSize    int  `env:"SCHEDULER_BATCH_SIZE" env-default:"50"`
+}
+
+type JobsConfig struct {
+	PollInterval uint `env:"JOBS_POLL_INTERVAL" env-default:"1"` // Период опроса очереди фоновых задач в секундах
+	Concurrency  int  `env:"JOBS_CONCURRENCY" env-default:"4"`
+	Lease        uint `env:"JOBS_LEASE" env-default:"60"` // Время аренды задачи экземпляром в секундах
+	MaxAttempts  int  `env:"JOBS_MAX_ATTEMPTS" env-default:"5"`
+	RetryBackoff uint `env:"JOBS_RETRY_BACKOFF" env-default:"5"`
+	MaxBackoff   uint `env:"JOBS_MAX_BACKOFF" env-default:"3600"`
+	// Admins имена пользователей через запятую, которым доступен список фоновых задач. Пустой список закрывает его для всех
+	Admins []string `env:"JOBS_ADMINS" env-separator:","`
+}
+
 type Config struct {
 	HTTPServer HTTPServerConfig
 	Postgres   Postgres
+	Outbox     OutboxConfig
+	Webhook    WebhookConfig
+	Mail       MailConfig
+	Scheduler  SchedulerConfig
+	Jobs       JobsConfig
 }
 
 func mustLoadConfig(log slog.Logger) *Config {
@@ -33,7 +93,7 @@ func mustLoadConfig(log slog.Logger) *Config {
 	config := new(Config)
 
 	if err := cleanenv.ReadEnv(config); err != nil {
-		log.Error("error reading config file: %s", err)
+		log.Error("error reading config file", sl.Err(err))
 		return nil
 	}
 
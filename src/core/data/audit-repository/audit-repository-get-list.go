@@ -0,0 +1,98 @@
+package audit_repository
+
+import (
+	"context"
+	"fmt"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+func (r AuditRepository) GetList(ctx context.Context, dto repositories.GetAuditListDTO) ([]domain.AuditEntry, error) {
+	query := `SELECT id, action, actor_id, organization_id, entity_type, entity_id, before, after, COALESCE(request_id, ''), created_at
+		FROM audit_log WHERE organization_id = $1`
+	args := []interface{}{dto.OrganizationID}
+	i := 2
+
+	if dto.EntityType != nil {
+		query += fmt.Sprintf(` AND entity_type = $%d`, i)
+		args = append(args, *dto.EntityType)
+		i++
+	}
+
+	if dto.EntityID != nil {
+		query += fmt.Sprintf(` AND entity_id = $%d`, i)
+		args = append(args, *dto.EntityID)
+		i++
+	}
+
+	if dto.Action != nil {
+		query += fmt.Sprintf(` AND action = $%d`, i)
+		args = append(args, *dto.Action)
+		i++
+	}
+
+	if dto.ActorID != nil {
+		query += fmt.Sprintf(` AND actor_id = $%d`, i)
+		args = append(args, *dto.ActorID)
+		i++
+	}
+
+	if dto.CreatedFrom != nil {
+		query += fmt.Sprintf(` AND created_at >= $%d`, i)
+		args = append(args, *dto.CreatedFrom)
+		i++
+	}
+
+	if dto.CreatedTo != nil {
+		query += fmt.Sprintf(` AND created_at < $%d`, i)
+		args = append(args, *dto.CreatedTo)
+		i++
+	}
+
+	if dto.After != nil {
+		value, err := dto.After.SortValue()
+		if err != nil {
+			return nil, err
+		}
+		query += fmt.Sprintf(` AND (created_at, id) < ($%d, $%d)`, i, i+1)
+		args = append(args, value, dto.After.ID)
+		i += 2
+	}
+
+	query += ` ORDER BY created_at DESC, id DESC`
+
+	if dto.Limit != nil {
+		query += fmt.Sprintf(` LIMIT $%d`, i)
+		args = append(args, dto.Limit)
+		i++
+	}
+
+	if dto.Offset != nil {
+		query += fmt.Sprintf(` OFFSET $%d`, i)
+		args = append(args, dto.Offset)
+		i++
+	}
+
+	rows, err := r.client.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := make([]domain.AuditEntry, 0)
+
+	for rows.Next() {
+		var (
+			e             domain.AuditEntry
+			before, after []byte
+		)
+		err := rows.Scan(&e.ID, &e.Action, &e.ActorID, &e.OrganizationID, &e.EntityType, &e.EntityID, &before, &after, &e.RequestID, &e.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		e.Before, e.After = before, after
+		entries = append(entries, e)
+	}
+
+	return entries, rows.Err()
+}
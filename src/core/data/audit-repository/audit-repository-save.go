@@ -0,0 +1,21 @@
+package audit_repository
+
+import (
+	"context"
+	"tms/src/core/domain"
+)
+
+func (r AuditRepository) Save(ctx context.Context, entry domain.AuditEntry) error {
+	query := `INSERT INTO audit_log(id, action, actor_id, organization_id, entity_type, entity_id, before, after, request_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NULLIF($9, ''), $10)`
+
+	// Пустой Before передается как NULL, а не как пустая строка JSONB
+	var before interface{}
+	if entry.Before != nil {
+		before = string(entry.Before)
+	}
+
+	_, err := r.client.Exec(ctx, query, entry.ID, entry.Action, entry.ActorID, entry.OrganizationID, entry.EntityType,
+		entry.EntityID, before, string(entry.After), entry.RequestID, entry.CreatedAt)
+	return err
+}
@@ -0,0 +1,16 @@
+package audit_repository
+
+import (
+	"tms/src/core/services/repositories"
+	"tms/src/pkg/pg"
+)
+
+type AuditRepository struct {
+	client pg.Client
+}
+
+func New(client pg.Client) repositories.AuditRepository {
+	return AuditRepository{
+		client: client,
+	}
+}
@@ -2,92 +2,50 @@ package bid_repository
 
 import (
 	"context"
-	"fmt"
 	"tms/src/core/domain"
 	"tms/src/core/services/repositories"
+	"tms/src/pkg/pg"
 )
 
 func (r BidRepository) GetList(ctx context.Context, dto repositories.GetBidListDTO) ([]domain.Bid, error) {
-	var (
-		queryBids = `SELECT id, name, description, status, tender_id, author_type, author_id, version, created_at FROM bid WHERE 1=1`
-
-		querySnapshots = `SELECT id, name, description, version FROM bid_snapshot WHERE bid_id = $1`
-	)
-
-	args := make([]interface{}, 0)
-	i := 1
-
-	if dto.ID != nil {
-		args = append(args, string(*dto.ID))
-		queryBids += fmt.Sprintf(" AND id = $%d", i)
-		i++
-	}
-
-	if dto.Status != nil {
-		args = append(args, *dto.Status)
-		queryBids += fmt.Sprintf(" AND status = $%d", i)
-		i++
-	}
-
-	if dto.AuthorType != nil {
-		args = append(args, *dto.AuthorType)
-		queryBids += fmt.Sprintf(" AND author_type = $%d", i)
-		i++
-	}
-
-	if dto.AuthorID != nil {
-		args = append(args, *dto.AuthorID)
-		queryBids += fmt.Sprintf(" AND author_id = $%d", i)
-		i++
-	}
-
-	if dto.TenderID != nil {
-		args = append(args, *dto.TenderID)
-		queryBids += fmt.Sprintf(" AND tender_id = $%d", i)
-		i++
-	}
-
-	if dto.Limit != nil {
-		args = append(args, *dto.Limit)
-		queryBids += fmt.Sprintf(" LIMIT $%d", i)
-		i++
-	}
-
-	if dto.Offset != nil {
-		args = append(args, *dto.Offset)
-		queryBids += fmt.Sprintf(" OFFSET $%d", i)
-		i++
+	q := pg.NewQuery(`SELECT id, name, description, price_amount, price_currency, status, tender_id, author_type, author_id, version, created_at, updated_at, editor_id, edited_at FROM bid WHERE 1=1`)
+	filter(q, dto)
+	if err := sortColumns.Paginate(q, dto.Sort, dto.After, dto.Limit, dto.Offset); err != nil {
+		return nil, err
 	}
 
-	rows, err := r.client.Query(ctx, queryBids, args...)
+	rows, err := r.client.Query(ctx, q.SQL(), q.Args()...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var bids []domain.Bid
+	var (
+		bids []domain.Bid
+		ids  []domain.ID
+	)
 
 	for rows.Next() {
 		var bid domain.Bid
-		err := rows.Scan(&bid.ID, &bid.Name, &bid.Description, &bid.Status, &bid.TenderID, &bid.AuthorType, &bid.AuthorID, &bid.Version, &bid.CreatedAt)
-		if err != nil {
-			return nil, err
-		}
-
-		snapshotRows, err := r.client.Query(ctx, querySnapshots, bid.ID)
+		err := rows.Scan(&bid.ID, &bid.Name, &bid.Description, &bid.Price.Amount, &bid.Price.Currency, &bid.Status, &bid.TenderID, &bid.AuthorType, &bid.AuthorID, &bid.Version, &bid.CreatedAt, &bid.UpdatedAt, &bid.EditorID, &bid.EditedAt)
 		if err != nil {
 			return nil, err
 		}
-		defer snapshotRows.Close()
 
-		for snapshotRows.Next() {
-			var s domain.BidSnapshot
-			if err := rows.Scan(&s.ID, &s.Name, &s.Description, &s.Version); err != nil {
-				return nil, err
-			}
-			bid.Snapshots = append(bid.Snapshots, s)
-		}
 		bids = append(bids, bid)
+		ids = append(ids, bid.ID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	snapshots, err := r.snapshots(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range bids {
+		bids[i].Snapshots = snapshots[bids[i].ID]
 	}
 
 	return bids, nil
@@ -0,0 +1,34 @@
+package bid_repository
+
+import (
+	"context"
+	"tms/src/core/domain"
+	"tms/src/pkg/pg"
+)
+
+func (r BidRepository) GetNormalizedPrices(ctx context.Context, ids []domain.ID) (map[domain.ID]domain.Money, error) {
+	query := `SELECT * FROM (
+			SELECT bid.id, ` + normalizedPriceColumn + ` AS amount, COALESCE(t.budget_currency, bid.price_currency) AS currency
+			FROM bid JOIN tender t ON t.id = bid.tender_id WHERE bid.id = ANY($1)
+		) prices WHERE amount IS NOT NULL`
+
+	rows, err := r.client.Query(ctx, query, pg.StringArray(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	prices := make(map[domain.ID]domain.Money, len(ids))
+	for rows.Next() {
+		var (
+			id    domain.ID
+			price domain.Money
+		)
+		if err := rows.Scan(&id, &price.Amount, &price.Currency); err != nil {
+			return nil, err
+		}
+		prices[id] = price
+	}
+
+	return prices, rows.Err()
+}
@@ -0,0 +1,28 @@
+package bid_repository
+
+import (
+	"context"
+	"tms/src/core/domain"
+)
+
+func (r BidRepository) GetStatusHistory(ctx context.Context, bidID domain.ID) ([]domain.BidStatusChange, error) {
+	query := `SELECT id, COALESCE(from_status, ''), to_status, editor_id, reason, created_at FROM bid_status_history
+		WHERE bid_id = $1 ORDER BY created_at, id`
+
+	rows, err := r.client.Query(ctx, query, bidID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	history := []domain.BidStatusChange{}
+	for rows.Next() {
+		var c domain.BidStatusChange
+		if err := rows.Scan(&c.ID, &c.From, &c.To, &c.EditorID, &c.Reason, &c.CreatedAt); err != nil {
+			return nil, err
+		}
+		history = append(history, c)
+	}
+
+	return history, rows.Err()
+}
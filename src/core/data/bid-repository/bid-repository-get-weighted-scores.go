@@ -0,0 +1,31 @@
+package bid_repository
+
+import (
+	"context"
+	"tms/src/core/domain"
+	"tms/src/pkg/pg"
+)
+
+func (r BidRepository) GetWeightedScores(ctx context.Context, ids []domain.ID) (map[domain.ID]float64, error) {
+	query := `SELECT bid.id, ` + weightedScoreColumn + ` FROM bid WHERE bid.id = ANY($1)`
+
+	rows, err := r.client.Query(ctx, query, pg.StringArray(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	scores := make(map[domain.ID]float64, len(ids))
+	for rows.Next() {
+		var (
+			id    domain.ID
+			score float64
+		)
+		if err := rows.Scan(&id, &score); err != nil {
+			return nil, err
+		}
+		scores[id] = score
+	}
+
+	return scores, rows.Err()
+}
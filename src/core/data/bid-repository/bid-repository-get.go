@@ -10,9 +10,9 @@ import (
 )
 
 func (r BidRepository) Get(ctx context.Context, dto repositories.GetBidDTO) (*domain.Bid, error) {
-	queryBid := `SELECT id, name, description, status, tender_id, author_type, author_id, version, created_at FROM bid WHERE id = $1`
+	queryBid := `SELECT id, name, description, price_amount, price_currency, status, tender_id, author_type, author_id, version, created_at, updated_at, editor_id, edited_at FROM bid WHERE id = $1`
 
-	querySnapshots := `SELECT id, name, description, version FROM bid_snapshot WHERE bid_id = $1`
+	querySnapshots := `SELECT id, name, description, price_amount, price_currency, version, created_at, editor_id FROM bid_snapshot WHERE bid_id = $1`
 
 	args := []interface{}{dto.ID}
 	i := 2
@@ -26,7 +26,7 @@ func (r BidRepository) Get(ctx context.Context, dto repositories.GetBidDTO) (*do
 
 	var bid domain.Bid
 
-	err := row.Scan(&bid.ID, &bid.Name, &bid.Description, &bid.Status, &bid.TenderID, &bid.AuthorType, &bid.AuthorID, &bid.Version, &bid.CreatedAt)
+	err := row.Scan(&bid.ID, &bid.Name, &bid.Description, &bid.Price.Amount, &bid.Price.Currency, &bid.Status, &bid.TenderID, &bid.AuthorType, &bid.AuthorID, &bid.Version, &bid.CreatedAt, &bid.UpdatedAt, &bid.EditorID, &bid.EditedAt)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, errors.Wrap(domain.ErrNotFound, "bid not found")
@@ -40,9 +40,10 @@ func (r BidRepository) Get(ctx context.Context, dto repositories.GetBidDTO) (*do
 	}
 	defer rows.Close()
 
+	bid.Snapshots = make([]domain.BidSnapshot, 0)
 	for rows.Next() {
 		var s domain.BidSnapshot
-		if err := rows.Scan(&s.ID, &s.Name, &s.Description, &s.Version); err != nil {
+		if err := rows.Scan(&s.ID, &s.Name, &s.Description, &s.Price.Amount, &s.Price.Currency, &s.Version, &s.CreatedAt, &s.EditorID); err != nil {
 			return nil, err
 		}
 		bid.Snapshots = append(bid.Snapshots, s)
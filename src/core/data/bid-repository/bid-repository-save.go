@@ -18,10 +18,14 @@ func (r BidRepository) Save(ctx context.Context, bid domain.Bid) error {
 
 		deleteSnapshots = `DELETE FROM bid_snapshot WHERE bid_id = $1`
 
-		insertBid = `INSERT INTO bid(ID, NAME, DESCRIPTION, STATUS, TENDER_ID, AUTHOR_TYPE, AUTHOR_ID, VERSION, CREATED_AT) 
+		insertBid = `INSERT INTO bid(ID, NAME, DESCRIPTION, PRICE_AMOUNT, PRICE_CURRENCY, STATUS, TENDER_ID, AUTHOR_TYPE, AUTHOR_ID, VERSION, CREATED_AT, UPDATED_AT, EDITOR_ID, EDITED_AT) 
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)`
+
+		insertSnapshot = `INSERT INTO bid_snapshot(id, bid_id, name, description, price_amount, price_currency, version, created_at, editor_id)
 			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
 
-		insertSnapshot = `INSERT INTO bid_snapshot(id, bid_id, name, description, version) VALUES ($1, $2, $3, $4, $5)`
+		insertStatusChange = `INSERT INTO bid_status_history(id, bid_id, from_status, to_status, editor_id, reason, created_at)
+			VALUES ($1, $2, NULLIF($3, ''), $4, $5, $6, $7) ON CONFLICT (id) DO NOTHING`
 	)
 
 	_, err = tx.Exec(ctx, deleteBid, bid.ID)
@@ -34,13 +38,20 @@ func (r BidRepository) Save(ctx context.Context, bid domain.Bid) error {
 		return err
 	}
 
-	_, err = tx.Exec(ctx, insertBid, bid.ID, bid.Name, bid.Description, bid.Status, bid.TenderID, bid.AuthorType, bid.AuthorID, bid.Version, bid.CreatedAt)
+	_, err = tx.Exec(ctx, insertBid, bid.ID, bid.Name, bid.Description, bid.Price.Amount, bid.Price.Currency, bid.Status, bid.TenderID, bid.AuthorType, bid.AuthorID, bid.Version, bid.CreatedAt, bid.UpdatedAt, bid.EditorID, bid.EditedAt)
 	if err != nil {
 		return err
 	}
 
 	for _, s := range bid.Snapshots {
-		_, err = tx.Exec(ctx, insertSnapshot, s.ID, bid.ID, s.Name, s.Description, s.Version)
+		_, err = tx.Exec(ctx, insertSnapshot, s.ID, bid.ID, s.Name, s.Description, s.Price.Amount, s.Price.Currency, s.Version, s.CreatedAt, s.EditorID)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, c := range bid.StatusChanges {
+		_, err = tx.Exec(ctx, insertStatusChange, c.ID, bid.ID, c.From, c.To, c.EditorID, c.Reason, c.CreatedAt)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,64 @@
+package bid_repository
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	list_query "tms/src/core/data/list-query"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+	"tms/src/pkg/pg"
+)
+
+func (r BidRepository) Search(ctx context.Context, dto repositories.GetBidListDTO) ([]repositories.BidSearchResult, error) {
+	if dto.Query == nil {
+		return nil, errors.Wrap(domain.ErrValidation, "search query is required")
+	}
+
+	q := pg.NewQuery(`SELECT id, name, description, price_amount, price_currency, status, tender_id, author_type, author_id, version, created_at, updated_at, editor_id, edited_at, ` + list_query.RankColumn + `,
+		` + list_query.Headline("name", "HighlightAll=true") + `,
+		` + list_query.Headline("description", "MaxWords=35, MinWords=15") + `
+		FROM bid WHERE 1=1`)
+	filter(q, dto)
+	if err := sortColumns.Paginate(q, dto.Sort, dto.After, dto.Limit, dto.Offset); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.client.Query(ctx, q.SQL(), q.Args()...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		results = make([]repositories.BidSearchResult, 0)
+		ids     []domain.ID
+	)
+
+	for rows.Next() {
+		var (
+			bid   domain.Bid
+			match repositories.SearchMatch
+		)
+		err := rows.Scan(&bid.ID, &bid.Name, &bid.Description, &bid.Price.Amount, &bid.Price.Currency, &bid.Status, &bid.TenderID, &bid.AuthorType, &bid.AuthorID, &bid.Version, &bid.CreatedAt, &bid.UpdatedAt, &bid.EditorID, &bid.EditedAt, &match.Rank, &match.Name, &match.Description)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, repositories.BidSearchResult{Bid: bid, Search: &match})
+		ids = append(ids, bid.ID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	snapshots, err := r.snapshots(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range results {
+		results[i].Bid.Snapshots = snapshots[results[i].Bid.ID]
+	}
+
+	return results, nil
+}
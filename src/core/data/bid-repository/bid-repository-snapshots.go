@@ -0,0 +1,38 @@
+package bid_repository
+
+import (
+	"context"
+	"tms/src/core/domain"
+	"tms/src/pkg/pg"
+)
+
+// snapshots загружает снимки bid с идентификаторами ids одним запросом.
+// Вызывается после закрытия строк основного запроса: в транзакции соединение не может читать два результата сразу
+func (r BidRepository) snapshots(ctx context.Context, ids []domain.ID) (map[domain.ID][]domain.BidSnapshot, error) {
+	query := `SELECT bid_id, id, name, description, price_amount, price_currency, version, created_at, editor_id FROM bid_snapshot
+		WHERE bid_id = ANY($1) ORDER BY bid_id, version`
+
+	rows, err := r.client.Query(ctx, query, pg.StringArray(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	snapshots := make(map[domain.ID][]domain.BidSnapshot, len(ids))
+	for _, id := range ids {
+		snapshots[id] = make([]domain.BidSnapshot, 0)
+	}
+
+	for rows.Next() {
+		var (
+			bidID domain.ID
+			s     domain.BidSnapshot
+		)
+		if err := rows.Scan(&bidID, &s.ID, &s.Name, &s.Description, &s.Price.Amount, &s.Price.Currency, &s.Version, &s.CreatedAt, &s.EditorID); err != nil {
+			return nil, err
+		}
+		snapshots[bidID] = append(snapshots[bidID], s)
+	}
+
+	return snapshots, rows.Err()
+}
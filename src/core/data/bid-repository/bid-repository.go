@@ -1,6 +1,7 @@
 package bid_repository
 
 import (
+	list_query "tms/src/core/data/list-query"
 	"tms/src/core/services/repositories"
 	"tms/src/pkg/pg"
 )
@@ -14,3 +15,57 @@ func New(client pg.Client) repositories.BidRepository {
 		client: client,
 	}
 }
+
+// normalizedPriceColumn Цена bid в валюте бюджета тендера по курсу организации тендера, действовавшему на дату создания bid.
+// Для тендера без бюджета цена не переводится, при отсутствии курса значение NULL.
+// Округление совпадает с domain.ExchangeRate.Convert
+const normalizedPriceColumn = `(SELECT CASE
+	WHEN t.budget_currency IS NULL OR t.budget_currency = bid.price_currency THEN bid.price_amount
+	ELSE (SELECT ROUND(bid.price_amount::NUMERIC * r.rate / 1000000)::BIGINT FROM exchange_rate r
+		WHERE r.organization_id = t.organization_id
+		AND r.from_currency = bid.price_currency AND r.to_currency = t.budget_currency
+		AND r.effective_date <= (bid.created_at AT TIME ZONE 'UTC')::DATE
+		ORDER BY r.effective_date DESC LIMIT 1)
+	END FROM tender t WHERE t.id = bid.tender_id)`
+
+// weightedScoreColumn Взвешенная оценка bid по текущим критериям тендера: среднее оценок ответственных
+// по каждому критерию, умноженное на его вес. Неоцененные критерии дают 0, округление до сотых
+const weightedScoreColumn = `(SELECT COALESCE(ROUND(SUM(s.score * c.weight / 100.0), 2), 0)::FLOAT8
+	FROM tender_scoring_criterion c
+	JOIN (SELECT criterion, AVG(score) AS score FROM bid_score WHERE bid_score.bid_id = bid.id GROUP BY criterion) s
+	ON s.criterion = c.name
+	WHERE c.tender_id = bid.tender_id)`
+
+// filter добавляет к запросу условия WHERE по фильтрам dto
+func filter(q *pg.Query, dto repositories.GetBidListDTO) {
+	list_query.Search(q, dto.Query)
+	list_query.Equal(q, "id", dto.ID)
+	list_query.Any(q, "id", dto.IDs)
+	list_query.Any(q, "status", dto.Statuses)
+	list_query.Equal(q, "author_type", dto.AuthorType)
+	list_query.Equal(q, "author_id", dto.AuthorID)
+	list_query.Equal(q, "tender_id", dto.TenderID)
+	list_query.Amount(q, "price", dto.Price)
+
+	if len(dto.ServiceTypes) > 0 {
+		q.Add(` AND tender_id IN (SELECT id FROM tender WHERE service_type = ANY(` + q.Arg(pg.StringArray(dto.ServiceTypes)) + `))`)
+	}
+
+	if len(dto.OrganizationIDs) > 0 {
+		q.Add(` AND tender_id IN (SELECT id FROM tender WHERE organization_id = ANY(` + q.Arg(pg.StringArray(dto.OrganizationIDs)) + `))`)
+	}
+
+	list_query.Time(q, dto.TimeFilter)
+}
+
+// sortColumns Колонки bid, соответствующие полям сортировки
+var sortColumns = list_query.SortColumns{
+	repositories.SortByName:      "name",
+	repositories.SortByCreatedAt: "created_at",
+	repositories.SortByVersion:   "version",
+	repositories.SortByRelevance: list_query.RankColumn,
+	repositories.SortByPrice:     "price_amount",
+	// Предложения без курса идут как предложения с нулевой ценой
+	repositories.SortByNormalizedPrice: "COALESCE(" + normalizedPriceColumn + ", 0)",
+	repositories.SortByScore:           weightedScoreColumn,
+}
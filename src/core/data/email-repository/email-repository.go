@@ -0,0 +1,81 @@
+package email_repository
+
+import (
+	"context"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+	"tms/src/pkg/pg"
+)
+
+type EmailRepository struct {
+	client pg.Client
+}
+
+func New(client pg.Client) repositories.EmailRepository {
+	return EmailRepository{
+		client: client,
+	}
+}
+
+const insertEmailQuery = `INSERT INTO email(id, recipient_id, event_id, address, subject, body, status, attempts,
+	next_attempt_at, last_error, created_at, sent_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`
+
+func (r EmailRepository) Append(ctx context.Context, emails ...domain.Email) error {
+	for _, e := range emails {
+		if err := r.exec(ctx, insertEmailQuery+` ON CONFLICT (recipient_id, event_id) DO NOTHING`, e); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r EmailRepository) Claim(ctx context.Context, dto repositories.ClaimEmailsDTO) ([]domain.Email, error) {
+	query := `WITH claimed AS (
+			UPDATE email SET next_attempt_at = CURRENT_TIMESTAMP + make_interval(secs => $3)
+			WHERE id IN (
+				SELECT id FROM email
+				WHERE status = $1 AND next_attempt_at <= CURRENT_TIMESTAMP
+				ORDER BY next_attempt_at, id
+				LIMIT $2
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING *
+		)
+		SELECT id, recipient_id, event_id, address, subject, body, status, attempts, next_attempt_at, last_error,
+			created_at, sent_at FROM claimed ORDER BY created_at, id`
+
+	rows, err := r.client.Query(ctx, query, domain.EmailPendingStatus, dto.Limit, dto.Lease.Seconds())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	emails := make([]domain.Email, 0)
+
+	for rows.Next() {
+		var e domain.Email
+		err := rows.Scan(&e.ID, &e.RecipientID, &e.EventID, &e.To, &e.Subject, &e.Body, &e.Status, &e.Attempts,
+			&e.NextAttemptAt, &e.LastError, &e.CreatedAt, &e.SentAt)
+		if err != nil {
+			return nil, err
+		}
+		emails = append(emails, e)
+	}
+
+	return emails, rows.Err()
+}
+
+func (r EmailRepository) Save(ctx context.Context, email domain.Email) error {
+	query := insertEmailQuery + ` ON CONFLICT (id) DO UPDATE SET status = EXCLUDED.status, attempts = EXCLUDED.attempts,
+		next_attempt_at = EXCLUDED.next_attempt_at, last_error = EXCLUDED.last_error, sent_at = EXCLUDED.sent_at`
+
+	return r.exec(ctx, query, email)
+}
+
+func (r EmailRepository) exec(ctx context.Context, query string, e domain.Email) error {
+	_, err := r.client.Exec(ctx, query, e.ID, e.RecipientID, e.EventID, e.To, e.Subject, e.Body, e.Status, e.Attempts,
+		e.NextAttemptAt, e.LastError, e.CreatedAt, e.SentAt)
+	return err
+}
@@ -10,7 +10,7 @@ import (
 )
 
 func (r EmployeeRepository) Get(ctx context.Context, dto repositories.GetEmployeeDTO) (*domain.Employee, error) {
-	query := `SELECT id, username, first_name, last_name FROM employee WHERE True`
+	query := `SELECT id, username, first_name, last_name, email FROM employee WHERE True`
 
 	args := make([]interface{}, 0)
 	i := 1
@@ -31,7 +31,7 @@ func (r EmployeeRepository) Get(ctx context.Context, dto repositories.GetEmploye
 
 	var employee domain.Employee
 
-	err := row.Scan(&employee.ID, &employee.Username, &employee.FirstName, &employee.LastName)
+	err := row.Scan(&employee.ID, &employee.Username, &employee.FirstName, &employee.LastName, &employee.Email)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, errors.Wrap(domain.ErrUserNotFound, "employee not found")
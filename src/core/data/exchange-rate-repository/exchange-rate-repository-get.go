@@ -0,0 +1,85 @@
+package exchange_rate_repository
+
+import (
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+func (r ExchangeRateRepository) GetList(ctx context.Context, dto repositories.GetExchangeRateListDTO) ([]domain.ExchangeRate, error) {
+	query := " AND organization_id = $1"
+	args := []interface{}{dto.OrganizationID}
+	i := 2
+
+	if dto.From != nil {
+		args = append(args, *dto.From)
+		query += fmt.Sprintf(" AND from_currency = $%d", i)
+		i++
+	}
+
+	if dto.To != nil {
+		args = append(args, *dto.To)
+		query += fmt.Sprintf(" AND to_currency = $%d", i)
+		i++
+	}
+
+	// На дату действует последний курс каждой пары, начавший действовать не позже нее
+	selectQuery := `SELECT `
+	if dto.Date != nil {
+		args = append(args, *dto.Date)
+		query += fmt.Sprintf(" AND effective_date <= $%d", i)
+		selectQuery = `SELECT DISTINCT ON (from_currency, to_currency) `
+		i++
+	}
+
+	query = selectQuery + selectExchangeRateColumns + ` FROM exchange_rate WHERE 1=1` + query
+	query += ` ORDER BY from_currency, to_currency, effective_date DESC`
+
+	if dto.Limit != nil {
+		args = append(args, *dto.Limit)
+		query += fmt.Sprintf(" LIMIT $%d", i)
+		i++
+	}
+
+	if dto.Offset != nil {
+		args = append(args, *dto.Offset)
+		query += fmt.Sprintf(" OFFSET $%d", i)
+		i++
+	}
+
+	return r.query(ctx, query, args...)
+}
+
+func (r ExchangeRateRepository) Get(ctx context.Context, id domain.ID) (*domain.ExchangeRate, error) {
+	query := `SELECT ` + selectExchangeRateColumns + ` FROM exchange_rate WHERE id = $1`
+
+	rate, err := scanExchangeRate(r.client.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.Wrap(domain.ErrNotFound, "exchange rate not found")
+		}
+		return nil, err
+	}
+
+	return rate, nil
+}
+
+func (r ExchangeRateRepository) Find(ctx context.Context, organizationID domain.ID, from, to domain.Currency, date time.Time) (*domain.ExchangeRate, error) {
+	query := `SELECT ` + selectExchangeRateColumns + ` FROM exchange_rate
+		WHERE organization_id = $1 AND from_currency = $2 AND to_currency = $3 AND effective_date <= $4
+		ORDER BY effective_date DESC LIMIT 1`
+
+	rate, err := scanExchangeRate(r.client.QueryRow(ctx, query, organizationID, from, to, date))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.Wrapf(domain.ErrNotFound, "no exchange rate from %s to %s on %s", from, to, date.Format(time.DateOnly))
+		}
+		return nil, err
+	}
+
+	return rate, nil
+}
@@ -0,0 +1,19 @@
+package exchange_rate_repository
+
+import (
+	"context"
+	"tms/src/core/domain"
+)
+
+func (r ExchangeRateRepository) Save(ctx context.Context, rate domain.ExchangeRate) error {
+	query := `INSERT INTO exchange_rate(id, organization_id, from_currency, to_currency, rate, effective_date, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	_, err := r.client.Exec(ctx, query, rate.ID, rate.OrganizationID, rate.From, rate.To, rate.Rate, rate.EffectiveDate, rate.CreatedAt)
+	return err
+}
+
+func (r ExchangeRateRepository) Delete(ctx context.Context, id domain.ID) error {
+	_, err := r.client.Exec(ctx, `DELETE FROM exchange_rate WHERE id = $1`, id)
+	return err
+}
@@ -0,0 +1,55 @@
+package exchange_rate_repository
+
+import (
+	"context"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+	"tms/src/pkg/pg"
+)
+
+type ExchangeRateRepository struct {
+	client pg.Client
+}
+
+func New(client pg.Client) repositories.ExchangeRateRepository {
+	return ExchangeRateRepository{
+		client: client,
+	}
+}
+
+const selectExchangeRateColumns = `id, organization_id, from_currency, to_currency, rate, effective_date, created_at`
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanExchangeRate(row scanner) (*domain.ExchangeRate, error) {
+	var r domain.ExchangeRate
+
+	err := row.Scan(&r.ID, &r.OrganizationID, &r.From, &r.To, &r.Rate, &r.EffectiveDate, &r.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+func (r ExchangeRateRepository) query(ctx context.Context, query string, args ...interface{}) ([]domain.ExchangeRate, error) {
+	rows, err := r.client.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rates := make([]domain.ExchangeRate, 0)
+
+	for rows.Next() {
+		rate, err := scanExchangeRate(rows)
+		if err != nil {
+			return nil, err
+		}
+		rates = append(rates, *rate)
+	}
+
+	return rates, rows.Err()
+}
@@ -0,0 +1,50 @@
+// Package fixtures Данные интеграционных тестов репозиториев
+package fixtures
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"testing"
+	bid_repository "tms/src/core/data/bid-repository"
+	tender_repository "tms/src/core/data/tender-repository"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+	"tms/src/pkg/pg/pgtest"
+)
+
+// Repositories Репозитории тестовой базы данных
+type Repositories struct {
+	Tenders repositories.TenderRepository
+	Bids    repositories.BidRepository
+}
+
+// ClosedTender создает в откатываемой транзакции тендер с предложениями от authors и вызывает fn
+// с событием его закрытия. Так подписчики проверяются в условиях relay, где все запросы идут через одно соединение
+func ClosedTender(t *testing.T, authors []domain.ID, fn func(ctx context.Context, r Repositories, event domain.TenderStatusChanged)) {
+	client := pgtest.New(t)
+	r := Repositories{
+		Tenders: tender_repository.New(*client),
+		Bids:    bid_repository.New(*client),
+	}
+
+	executor := domain.OrganizationResponsible{OrganizationID: "org", UserID: "responsible"}
+	price := domain.Money{Amount: 100, Currency: domain.RUBCurrency}
+
+	pgtest.Rollback(t, client, func(ctx context.Context) {
+		tender, err := domain.NewTender("Доставка", "Описание", string(domain.TenderDeliveryServiceType), "org", nil, nil, nil, executor)
+		require.NoError(t, err)
+		require.NoError(t, r.Tenders.Save(ctx, *tender))
+
+		for _, author := range authors {
+			bid, err := domain.NewBid("Предложение", "Описание", string(domain.BidAuthorUserType), price, tender.ID, author)
+			require.NoError(t, err)
+			require.NoError(t, r.Bids.Save(ctx, *bid))
+		}
+
+		fn(ctx, r, domain.TenderStatusChanged{
+			TenderID: tender.ID,
+			From:     domain.TenderPublishedStatus,
+			To:       domain.TenderClosedStatus,
+		})
+	})
+}
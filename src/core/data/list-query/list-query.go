@@ -0,0 +1,117 @@
+package list_query
+
+import (
+	"fmt"
+	"tms/src/core/services/repositories"
+	"tms/src/pkg/pg"
+)
+
+// SearchQuery Поисковый запрос в обеих конфигурациях, сам текст запроса всегда передается параметром $1
+const SearchQuery = `(websearch_to_tsquery('russian', $1) || websearch_to_tsquery('english', $1))`
+
+// RankColumn Релевантность строки поисковому запросу
+const RankColumn = `ts_rank(search_vector, ` + SearchQuery + `)`
+
+// Headline возвращает выражение фрагмента column, в котором совпадения с запросом выделены тегом <mark>.
+// ts_headline принимает одну конфигурацию, поэтому фрагмент строится той, по которой column совпадает с запросом:
+// русской, а если совпадения нет - английской, как и search_vector
+func Headline(column, options string) string {
+	options = `'StartSel=<mark>, StopSel=</mark>, ` + options + `'`
+	return `CASE WHEN to_tsvector('russian', ` + column + `) @@ websearch_to_tsquery('russian', $1)
+		THEN ts_headline('russian', ` + column + `, websearch_to_tsquery('russian', $1), ` + options + `)
+		ELSE ts_headline('english', ` + column + `, websearch_to_tsquery('english', $1), ` + options + `) END`
+}
+
+// Search добавляет условие полнотекстового поиска. Вызывается первым, чтобы запрос занял $1
+func Search(q *pg.Query, query *string) {
+	if query != nil {
+		q.Arg(*query)
+		q.Add(` AND search_vector @@ ` + SearchQuery)
+	}
+}
+
+// Equal добавляет условие column = value, если value задано
+func Equal[T any](q *pg.Query, column string, value *T) {
+	if value != nil {
+		q.Add(` AND ` + column + ` = ` + q.Arg(*value))
+	}
+}
+
+// Any добавляет условие column = ANY(values), если values не пуст
+func Any[T ~string](q *pg.Query, column string, values []T) {
+	if len(values) > 0 {
+		q.Add(` AND ` + column + ` = ANY(` + q.Arg(pg.StringArray(values)) + `)`)
+	}
+}
+
+// Amount добавляет фильтр по сумме в колонках <prefix>_currency и <prefix>_amount
+func Amount(q *pg.Query, prefix string, f repositories.AmountFilter) {
+	Equal(q, prefix+`_currency`, f.Currency)
+
+	if f.Min != nil {
+		q.Add(` AND ` + prefix + `_amount >= ` + q.Arg(*f.Min))
+	}
+	if f.Max != nil {
+		q.Add(` AND ` + prefix + `_amount <= ` + q.Arg(*f.Max))
+	}
+}
+
+// Time добавляет фильтры по колонкам created_at и updated_at
+func Time(q *pg.Query, f repositories.TimeFilter) {
+	if f.CreatedFrom != nil {
+		q.Add(` AND created_at >= ` + q.Arg(*f.CreatedFrom))
+	}
+	if f.CreatedTo != nil {
+		q.Add(` AND created_at < ` + q.Arg(*f.CreatedTo))
+	}
+	if f.UpdatedSince != nil {
+		q.Add(` AND updated_at >= ` + q.Arg(*f.UpdatedSince))
+	}
+}
+
+// SortColumns Колонки таблицы, соответствующие полям сортировки. Поля без колонки сортируются по name
+type SortColumns map[repositories.SortField]string
+
+func (c SortColumns) column(field repositories.SortField) string {
+	if column, ok := c[field]; ok {
+		return column
+	}
+	return "name"
+}
+
+// Paginate дописывает к запросу условие курсора after, ORDER BY, LIMIT и OFFSET.
+// В ORDER BY добавляется id для стабильного порядка между страницами
+func (c SortColumns) Paginate(q *pg.Query, sort *repositories.Sort, after *repositories.Cursor, limit *repositories.Limit, offset *repositories.Offset) error {
+	if after != nil {
+		value, err := after.SortValue()
+		if err != nil {
+			return err
+		}
+
+		op := ">"
+		if after.Sort.Direction == repositories.SortDesc {
+			op = "<"
+		}
+
+		q.Add(fmt.Sprintf(` AND (%s, id) %s (%s, %s)`, c.column(after.Sort.Field), op, q.Arg(value), q.Arg(after.ID)))
+	}
+
+	if sort == nil {
+		q.Add(` ORDER BY name ASC, id ASC`)
+	} else {
+		direction := "ASC"
+		if sort.Direction == repositories.SortDesc {
+			direction = "DESC"
+		}
+		q.Add(fmt.Sprintf(` ORDER BY %s %s, id %s`, c.column(sort.Field), direction, direction))
+	}
+
+	if limit != nil {
+		q.Add(` LIMIT ` + q.Arg(*limit))
+	}
+	if offset != nil {
+		q.Add(` OFFSET ` + q.Arg(*offset))
+	}
+
+	return nil
+}
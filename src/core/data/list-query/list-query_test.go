@@ -0,0 +1,60 @@
+package list_query
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+	"tms/src/pkg/pg"
+)
+
+var columns = SortColumns{
+	repositories.SortByName:    "name",
+	repositories.SortByVersion: "version",
+}
+
+func TestPaginate(t *testing.T) {
+	sort := repositories.Sort{Field: repositories.SortByVersion, Direction: repositories.SortDesc}
+	after := repositories.Cursor{Sort: sort, Value: "3", ID: "bid-1"}
+	limit := repositories.Limit(5)
+
+	q := pg.NewQuery(`SELECT id FROM bid WHERE 1=1`)
+	Any(q, "status", []domain.BidStatus{domain.BidPublishedStatus})
+	require.NoError(t, columns.Paginate(q, &sort, &after, &limit, nil))
+
+	assert.Equal(t, `SELECT id FROM bid WHERE 1=1 AND status = ANY($1) AND (version, id) < ($2, $3) ORDER BY version DESC, id DESC LIMIT $4`, q.SQL())
+	assert.Equal(t, []interface{}{[]string{"Published"}, 3, domain.ID("bid-1"), limit}, q.Args())
+}
+
+func TestPaginate_DefaultOrder(t *testing.T) {
+	q := pg.NewQuery(`SELECT id FROM tender WHERE 1=1`)
+	require.NoError(t, columns.Paginate(q, nil, nil, nil, nil))
+	assert.Equal(t, `SELECT id FROM tender WHERE 1=1 ORDER BY name ASC, id ASC`, q.SQL())
+
+	q = pg.NewQuery(`SELECT id FROM tender WHERE 1=1`)
+	unknown := repositories.Sort{Field: repositories.SortByBudget, Direction: repositories.SortAsc}
+	require.NoError(t, columns.Paginate(q, &unknown, nil, nil, nil))
+	assert.Equal(t, `SELECT id FROM tender WHERE 1=1 ORDER BY name ASC, id ASC`, q.SQL())
+}
+
+func TestSearch_TakesFirstParameter(t *testing.T) {
+	query := "road"
+	amount := int64(100)
+	currency := domain.RUBCurrency
+
+	q := pg.NewQuery(`SELECT id FROM tender WHERE 1=1`)
+	Search(q, &query)
+	Amount(q, "budget", repositories.AmountFilter{Currency: &currency, Min: &amount})
+
+	assert.Equal(t, `SELECT id FROM tender WHERE 1=1 AND search_vector @@ `+SearchQuery+` AND budget_currency = $2 AND budget_amount >= $3`, q.SQL())
+	assert.Equal(t, []interface{}{"road", currency, amount}, q.Args())
+}
+
+func TestHeadline_UsesSearchConfigurations(t *testing.T) {
+	headline := Headline("name", "HighlightAll=true")
+
+	assert.Contains(t, headline, `to_tsvector('russian', name) @@ websearch_to_tsquery('russian', $1)`)
+	assert.Contains(t, headline, `ts_headline('russian', name, websearch_to_tsquery('russian', $1), 'StartSel=<mark>, StopSel=</mark>, HighlightAll=true')`)
+	assert.Contains(t, headline, `ts_headline('english', name, websearch_to_tsquery('english', $1), 'StartSel=<mark>, StopSel=</mark>, HighlightAll=true')`)
+}
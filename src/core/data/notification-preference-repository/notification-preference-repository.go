@@ -0,0 +1,46 @@
+package notification_preference_repository
+
+import (
+	"context"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+	"tms/src/pkg/pg"
+)
+
+type NotificationPreferenceRepository struct {
+	client pg.Client
+}
+
+func New(client pg.Client) repositories.NotificationPreferenceRepository {
+	return NotificationPreferenceRepository{
+		client: client,
+	}
+}
+
+func (r NotificationPreferenceRepository) Get(ctx context.Context, employeeID domain.ID) (*domain.NotificationPreferences, error) {
+	query := `SELECT employee_id, email_enabled, language, updated_at FROM notification_preference WHERE employee_id = $1`
+
+	var p domain.NotificationPreferences
+
+	err := r.client.QueryRow(ctx, query, employeeID).Scan(&p.EmployeeID, &p.EmailEnabled, &p.Language, &p.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.Wrap(domain.ErrNotFound, "notification preferences not found")
+		}
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+func (r NotificationPreferenceRepository) Save(ctx context.Context, p domain.NotificationPreferences) error {
+	query := `INSERT INTO notification_preference(employee_id, email_enabled, language, updated_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (employee_id) DO UPDATE SET email_enabled = EXCLUDED.email_enabled, language = EXCLUDED.language,
+		updated_at = EXCLUDED.updated_at`
+
+	_, err := r.client.Exec(ctx, query, p.EmployeeID, p.EmailEnabled, p.Language, p.UpdatedAt)
+	return err
+}
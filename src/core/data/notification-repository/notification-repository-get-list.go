@@ -0,0 +1,59 @@
+package notification_repository
+
+import (
+	"context"
+	"fmt"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+func (r NotificationRepository) GetList(ctx context.Context, dto repositories.GetNotificationsListDTO) ([]domain.Notification, error) {
+	query := selectNotificationQuery + ` WHERE recipient_id = $1`
+	args := []interface{}{dto.RecipientID}
+	i := 2
+
+	if dto.Unread {
+		query += ` AND read_at IS NULL`
+	}
+
+	query += ` ORDER BY created_at DESC, id DESC`
+
+	if dto.Limit != nil {
+		query += fmt.Sprintf(` LIMIT $%d`, i)
+		args = append(args, dto.Limit)
+		i++
+	}
+
+	if dto.Offset != nil {
+		query += fmt.Sprintf(` OFFSET $%d`, i)
+		args = append(args, dto.Offset)
+		i++
+	}
+
+	rows, err := r.client.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	notifications := make([]domain.Notification, 0)
+
+	for rows.Next() {
+		notification, err := scanNotification(rows)
+		if err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, *notification)
+	}
+
+	return notifications, rows.Err()
+}
+
+func (r NotificationRepository) CountUnread(ctx context.Context, recipientID domain.ID) (int, error) {
+	var count int
+
+	err := r.client.QueryRow(ctx, `SELECT COUNT(*) FROM notification WHERE recipient_id = $1 AND read_at IS NULL`, recipientID).
+		Scan(&count)
+
+	return count, err
+}
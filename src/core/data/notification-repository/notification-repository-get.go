@@ -0,0 +1,20 @@
+package notification_repository
+
+import (
+	"context"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+	"tms/src/core/domain"
+)
+
+func (r NotificationRepository) Get(ctx context.Context, id domain.ID) (*domain.Notification, error) {
+	notification, err := scanNotification(r.client.QueryRow(ctx, selectNotificationQuery+` WHERE id = $1`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.Wrap(domain.ErrNotFound, "notification not found")
+		}
+		return nil, err
+	}
+
+	return notification, nil
+}
@@ -0,0 +1,41 @@
+package notification_repository
+
+import (
+	"context"
+	"time"
+	"tms/src/core/domain"
+)
+
+const insertNotificationQuery = `INSERT INTO notification(id, recipient_id, kind, event_id, tender_id, bid_id, message,
+	created_at, read_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+
+func (r NotificationRepository) Append(ctx context.Context, notifications ...domain.Notification) error {
+	for _, n := range notifications {
+		if err := r.exec(ctx, insertNotificationQuery+` ON CONFLICT (recipient_id, event_id) DO NOTHING`, n); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r NotificationRepository) Save(ctx context.Context, notification domain.Notification) error {
+	return r.exec(ctx, insertNotificationQuery+` ON CONFLICT (id) DO UPDATE SET read_at = EXCLUDED.read_at`, notification)
+}
+
+func (r NotificationRepository) ReadAll(ctx context.Context, recipientID domain.ID, readAt time.Time) (int, error) {
+	tag, err := r.client.Exec(ctx, `UPDATE notification SET read_at = $2 WHERE recipient_id = $1 AND read_at IS NULL`,
+		recipientID, readAt)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(tag.RowsAffected()), nil
+}
+
+func (r NotificationRepository) exec(ctx context.Context, query string, n domain.Notification) error {
+	_, err := r.client.Exec(ctx, query, n.ID, n.RecipientID, n.Kind, n.EventID, n.TenderID, n.BidID, n.Message,
+		n.CreatedAt, n.ReadAt)
+	return err
+}
@@ -0,0 +1,35 @@
+package notification_repository
+
+import (
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+	"tms/src/pkg/pg"
+)
+
+type NotificationRepository struct {
+	client pg.Client
+}
+
+func New(client pg.Client) repositories.NotificationRepository {
+	return NotificationRepository{
+		client: client,
+	}
+}
+
+const selectNotificationQuery = `SELECT id, recipient_id, kind, event_id, tender_id, bid_id, message, created_at, read_at
+	FROM notification`
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanNotification(row scanner) (*domain.Notification, error) {
+	var n domain.Notification
+
+	err := row.Scan(&n.ID, &n.RecipientID, &n.Kind, &n.EventID, &n.TenderID, &n.BidID, &n.Message, &n.CreatedAt, &n.ReadAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &n, nil
+}
@@ -0,0 +1,20 @@
+package outbox_repository
+
+import (
+	"context"
+	"tms/src/core/domain"
+)
+
+func (r OutboxRepository) Append(ctx context.Context, messages ...domain.OutboxMessage) error {
+	query := `INSERT INTO outbox(id, event_name, aggregate_id, payload, occurred_at)
+		VALUES ($1, $2, $3, $4, $5) ON CONFLICT (id) DO NOTHING`
+
+	for _, m := range messages {
+		_, err := r.client.Exec(ctx, query, m.ID, m.EventName, m.AggregateID, string(m.Payload), m.OccurredAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
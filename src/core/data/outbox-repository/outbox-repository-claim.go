@@ -0,0 +1,25 @@
+package outbox_repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+func (r OutboxRepository) Claim(ctx context.Context, dto repositories.ClaimOutboxDTO) ([]domain.OutboxMessage, error) {
+	query := `WITH claimed AS (
+			UPDATE outbox SET next_attempt_at = CURRENT_TIMESTAMP + make_interval(secs => $3), lock_token = $4
+			WHERE id IN (
+				SELECT id FROM outbox
+				WHERE delivered_at IS NULL AND dead_at IS NULL AND attempts < $1 AND next_attempt_at <= CURRENT_TIMESTAMP
+				ORDER BY seq
+				LIMIT $2
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING id, seq, stream_seq, event_name, aggregate_id, payload, occurred_at, attempts, lock_token
+		)
+		SELECT id, seq, stream_seq, event_name, aggregate_id, payload, occurred_at, attempts, lock_token FROM claimed ORDER BY seq`
+
+	return r.query(ctx, query, dto.MaxAttempts, dto.Limit, dto.Lease.Seconds(), uuid.NewString())
+}
@@ -0,0 +1,31 @@
+package outbox_repository
+
+import (
+	"context"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+func (r OutboxRepository) Get(ctx context.Context, id domain.ID) (*domain.OutboxMessage, error) {
+	m, err := scanMessage(r.client.QueryRow(ctx, selectMessageQuery+` WHERE id = $1`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.Wrap(domain.ErrNotFound, "outbox message not found")
+		}
+		return nil, err
+	}
+
+	return m, nil
+}
+
+func (r OutboxRepository) GetList(ctx context.Context, dto repositories.GetOutboxListDTO) ([]domain.OutboxMessage, error) {
+	return r.query(ctx, selectMessageQuery+` WHERE stream_seq > $1 ORDER BY stream_seq LIMIT $2`, dto.AfterStreamSeq, dto.Limit)
+}
+
+func (r OutboxRepository) LastStreamSeq(ctx context.Context) (int64, error) {
+	var seq int64
+	err := r.client.QueryRow(ctx, `SELECT COALESCE(MAX(stream_seq), 0) FROM outbox`).Scan(&seq)
+	return seq, err
+}
@@ -0,0 +1,54 @@
+package outbox_repository
+
+import (
+	"context"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+func (r OutboxRepository) MarkSubscriberDelivered(ctx context.Context, id domain.ID, subscriber string) (bool, error) {
+	query := `INSERT INTO outbox_delivery(message_id, subscriber) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+
+	tag, err := r.client.Exec(ctx, query, id, subscriber)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+// leased условие действующей аренды сообщения, параметры $1 и $2 - id и lock_token сообщения
+const leased = `id = $1 AND lock_token = $2 AND delivered_at IS NULL AND dead_at IS NULL`
+
+func (r OutboxRepository) MarkDelivered(ctx context.Context, m domain.OutboxMessage) error {
+	query := `UPDATE outbox SET delivered_at = CURRENT_TIMESTAMP, last_error = NULL, lock_token = NULL WHERE ` + leased
+
+	return r.finish(ctx, query, m)
+}
+
+func (r OutboxRepository) MarkFailed(ctx context.Context, m domain.OutboxMessage, nextAttemptAt time.Time, reason string) error {
+	query := `UPDATE outbox SET attempts = attempts + 1, next_attempt_at = $3, last_error = $4, lock_token = NULL WHERE ` + leased
+
+	return r.finish(ctx, query, m, nextAttemptAt, reason)
+}
+
+func (r OutboxRepository) MarkDead(ctx context.Context, m domain.OutboxMessage, reason string) error {
+	query := `UPDATE outbox SET attempts = attempts + 1, dead_at = CURRENT_TIMESTAMP, last_error = $3, lock_token = NULL
+		WHERE ` + leased
+
+	return r.finish(ctx, query, m, reason)
+}
+
+// finish выполняет query по аренде m и возвращает ErrLeaseLost, если аренда уже не действует
+func (r OutboxRepository) finish(ctx context.Context, query string, m domain.OutboxMessage, args ...interface{}) error {
+	tag, err := r.client.Exec(ctx, query, append([]interface{}{m.ID, m.LockToken}, args...)...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return repositories.ErrLeaseLost
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package outbox_repository
+
+import (
+	"context"
+	"tms/src/core/domain"
+)
+
+// streamSeqLock ключ advisory-блокировки, под которой выдаются StreamSeq
+const streamSeqLock = 7_146_001
+
+func (r OutboxRepository) AssignStreamSeq(ctx context.Context, id domain.ID) error {
+	if _, err := r.client.Exec(ctx, `SELECT pg_advisory_xact_lock($1)`, streamSeqLock); err != nil {
+		return err
+	}
+
+	query := `UPDATE outbox SET stream_seq = (SELECT COALESCE(MAX(stream_seq), 0) + 1 FROM outbox)
+		WHERE id = $1 AND stream_seq IS NULL`
+
+	_, err := r.client.Exec(ctx, query, id)
+	return err
+}
@@ -0,0 +1,59 @@
+package outbox_repository
+
+import (
+	"context"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+	"tms/src/pkg/pg"
+)
+
+type OutboxRepository struct {
+	client pg.Client
+}
+
+func New(client pg.Client) repositories.OutboxRepository {
+	return OutboxRepository{
+		client: client,
+	}
+}
+
+const selectMessageQuery = `SELECT id, seq, stream_seq, event_name, aggregate_id, payload, occurred_at, attempts, lock_token FROM outbox`
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanMessage(row scanner) (*domain.OutboxMessage, error) {
+	var (
+		m       domain.OutboxMessage
+		payload []byte
+	)
+
+	err := row.Scan(&m.ID, &m.Seq, &m.StreamSeq, &m.EventName, &m.AggregateID, &payload, &m.OccurredAt, &m.Attempts, &m.LockToken)
+	if err != nil {
+		return nil, err
+	}
+	m.Payload = payload
+
+	return &m, nil
+}
+
+func (r OutboxRepository) query(ctx context.Context, query string, args ...interface{}) ([]domain.OutboxMessage, error) {
+	rows, err := r.client.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	messages := make([]domain.OutboxMessage, 0)
+
+	for rows.Next() {
+		m, err := scanMessage(rows)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, *m)
+	}
+
+	return messages, rows.Err()
+}
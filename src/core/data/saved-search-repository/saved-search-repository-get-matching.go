@@ -0,0 +1,17 @@
+package saved_search_repository
+
+import (
+	"context"
+	"tms/src/core/domain"
+)
+
+// GetMatching сопоставляет тендер с условиями поисков в базе, поисковый запрос проверяется так же, как при поиске тендеров
+func (r SavedSearchRepository) GetMatching(ctx context.Context, tenderID domain.ID) ([]domain.SavedSearch, error) {
+	query := selectSavedSearchQuery + ` JOIN tender t ON t.id = $1
+		WHERE (cardinality(s.service_types) = 0 OR t.service_type = ANY(s.service_types))
+		AND (cardinality(s.organization_ids) = 0 OR t.organization_id = ANY(s.organization_ids))
+		AND (s.query IS NULL OR t.search_vector @@ (websearch_to_tsquery('russian', s.query) || websearch_to_tsquery('english', s.query)))
+		ORDER BY s.created_at, s.id`
+
+	return r.query(ctx, query, tenderID)
+}
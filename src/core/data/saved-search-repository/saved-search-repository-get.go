@@ -0,0 +1,24 @@
+package saved_search_repository
+
+import (
+	"context"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+	"tms/src/core/domain"
+)
+
+func (r SavedSearchRepository) GetList(ctx context.Context, employeeID domain.ID) ([]domain.SavedSearch, error) {
+	return r.query(ctx, selectSavedSearchQuery+` WHERE s.employee_id = $1 ORDER BY s.created_at, s.id`, employeeID)
+}
+
+func (r SavedSearchRepository) Get(ctx context.Context, id domain.ID) (*domain.SavedSearch, error) {
+	search, err := scanSavedSearch(r.client.QueryRow(ctx, selectSavedSearchQuery+` WHERE s.id = $1`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.Wrap(domain.ErrNotFound, "saved search not found")
+		}
+		return nil, err
+	}
+
+	return search, nil
+}
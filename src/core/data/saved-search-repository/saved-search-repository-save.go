@@ -0,0 +1,23 @@
+package saved_search_repository
+
+import (
+	"context"
+	"tms/src/core/domain"
+	"tms/src/pkg/pg"
+)
+
+func (r SavedSearchRepository) Save(ctx context.Context, search domain.SavedSearch) error {
+	query := `INSERT INTO saved_search(id, employee_id, name, service_types, organization_ids, query, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name, service_types = EXCLUDED.service_types,
+		organization_ids = EXCLUDED.organization_ids, query = EXCLUDED.query, updated_at = EXCLUDED.updated_at`
+
+	_, err := r.client.Exec(ctx, query, search.ID, search.EmployeeID, search.Name, pg.StringArray(search.ServiceTypes),
+		pg.StringArray(search.OrganizationIDs), search.Query, search.CreatedAt, search.UpdatedAt)
+	return err
+}
+
+func (r SavedSearchRepository) Delete(ctx context.Context, id domain.ID) error {
+	_, err := r.client.Exec(ctx, `DELETE FROM saved_search WHERE id = $1`, id)
+	return err
+}
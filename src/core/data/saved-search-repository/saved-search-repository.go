@@ -0,0 +1,70 @@
+package saved_search_repository
+
+import (
+	"context"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+	"tms/src/pkg/pg"
+)
+
+type SavedSearchRepository struct {
+	client pg.Client
+}
+
+func New(client pg.Client) repositories.SavedSearchRepository {
+	return SavedSearchRepository{
+		client: client,
+	}
+}
+
+const selectSavedSearchQuery = `SELECT s.id, s.employee_id, s.name, s.service_types, s.organization_ids, s.query, s.created_at,
+	s.updated_at FROM saved_search s`
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanSavedSearch(row scanner) (*domain.SavedSearch, error) {
+	var (
+		s               domain.SavedSearch
+		serviceTypes    []string
+		organizationIDs []string
+	)
+
+	err := row.Scan(&s.ID, &s.EmployeeID, &s.Name, &serviceTypes, &organizationIDs, &s.Query, &s.CreatedAt, &s.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	s.ServiceTypes = make([]domain.TenderServiceType, 0, len(serviceTypes))
+	for _, t := range serviceTypes {
+		s.ServiceTypes = append(s.ServiceTypes, domain.TenderServiceType(t))
+	}
+
+	s.OrganizationIDs = make([]domain.ID, 0, len(organizationIDs))
+	for _, id := range organizationIDs {
+		s.OrganizationIDs = append(s.OrganizationIDs, domain.ID(id))
+	}
+
+	return &s, nil
+}
+
+func (r SavedSearchRepository) query(ctx context.Context, query string, args ...interface{}) ([]domain.SavedSearch, error) {
+	rows, err := r.client.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	searches := make([]domain.SavedSearch, 0)
+
+	for rows.Next() {
+		search, err := scanSavedSearch(rows)
+		if err != nil {
+			return nil, err
+		}
+		searches = append(searches, *search)
+	}
+
+	return searches, rows.Err()
+}
@@ -0,0 +1,45 @@
+package scoring_repository
+
+import (
+	"context"
+	"tms/src/core/domain"
+)
+
+func (r ScoringRepository) GetCriteria(ctx context.Context, tenderID domain.ID) (domain.ScoringCriteria, error) {
+	query := `SELECT name, weight FROM tender_scoring_criterion WHERE tender_id = $1 ORDER BY position`
+
+	rows, err := r.client.Query(ctx, query, tenderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	criteria := domain.ScoringCriteria{}
+	for rows.Next() {
+		var c domain.ScoringCriterion
+		if err := rows.Scan(&c.Name, &c.Weight); err != nil {
+			return nil, err
+		}
+		criteria = append(criteria, c)
+	}
+
+	return criteria, rows.Err()
+}
+
+// SaveCriteria удаляет прежние критерии и вставляет новые, вызывающий код выполняет его в транзакции
+func (r ScoringRepository) SaveCriteria(ctx context.Context, tenderID domain.ID, criteria domain.ScoringCriteria) error {
+	_, err := r.client.Exec(ctx, `DELETE FROM tender_scoring_criterion WHERE tender_id = $1`, tenderID)
+	if err != nil {
+		return err
+	}
+
+	query := `INSERT INTO tender_scoring_criterion(tender_id, name, weight, position) VALUES ($1, $2, $3, $4)`
+
+	for i, c := range criteria {
+		if _, err := r.client.Exec(ctx, query, tenderID, c.Name, c.Weight, i); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
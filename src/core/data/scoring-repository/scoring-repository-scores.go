@@ -0,0 +1,71 @@
+package scoring_repository
+
+import (
+	"context"
+	"fmt"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+	"tms/src/pkg/pg"
+)
+
+func (r ScoringRepository) GetScores(ctx context.Context, dto repositories.GetBidScoresDTO) ([]domain.BidScore, error) {
+	query := `SELECT bid_id, evaluator_id, criterion, score, updated_at FROM bid_score WHERE 1=1`
+	args := make([]interface{}, 0)
+	i := 1
+
+	if dto.TenderID != nil {
+		args = append(args, *dto.TenderID)
+		query += fmt.Sprintf(" AND bid_id IN (SELECT id FROM bid WHERE tender_id = $%d)", i)
+		i++
+	}
+
+	if dto.BidID != nil {
+		args = append(args, *dto.BidID)
+		query += fmt.Sprintf(" AND bid_id = $%d", i)
+		i++
+	}
+
+	if dto.BidIDs != nil {
+		args = append(args, pg.StringArray(dto.BidIDs))
+		query += fmt.Sprintf(" AND bid_id = ANY($%d)", i)
+		i++
+	}
+
+	if dto.EvaluatorID != nil {
+		args = append(args, *dto.EvaluatorID)
+		query += fmt.Sprintf(" AND evaluator_id = $%d", i)
+		i++
+	}
+
+	query += ` ORDER BY bid_id, evaluator_id, criterion`
+
+	rows, err := r.client.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	scores := []domain.BidScore{}
+	for rows.Next() {
+		var s domain.BidScore
+		if err := rows.Scan(&s.BidID, &s.EvaluatorID, &s.Criterion, &s.Score, &s.UpdatedAt); err != nil {
+			return nil, err
+		}
+		scores = append(scores, s)
+	}
+
+	return scores, rows.Err()
+}
+
+func (r ScoringRepository) SaveScores(ctx context.Context, scores []domain.BidScore) error {
+	query := `INSERT INTO bid_score(bid_id, evaluator_id, criterion, score, updated_at) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (bid_id, evaluator_id, criterion) DO UPDATE SET score = EXCLUDED.score, updated_at = EXCLUDED.updated_at`
+
+	for _, s := range scores {
+		if _, err := r.client.Exec(ctx, query, s.BidID, s.EvaluatorID, s.Criterion, s.Score, s.UpdatedAt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
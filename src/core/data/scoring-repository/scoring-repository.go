@@ -0,0 +1,16 @@
+package scoring_repository
+
+import (
+	"tms/src/core/services/repositories"
+	"tms/src/pkg/pg"
+)
+
+type ScoringRepository struct {
+	client pg.Client
+}
+
+func New(client pg.Client) repositories.ScoringRepository {
+	return ScoringRepository{
+		client: client,
+	}
+}
@@ -0,0 +1,49 @@
+package tender_repository
+
+import (
+	"context"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+func (r TenderRepository) ClaimExpired(ctx context.Context, dto repositories.ClaimExpiredTendersDTO) ([]domain.ID, error) {
+	query := `SELECT id FROM tender
+		WHERE status != $1 AND submission_deadline <= $3
+		ORDER BY submission_deadline, id
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED`
+
+	return r.claim(ctx, query, domain.TenderClosedStatus, dto.Limit, dto.Now)
+}
+
+func (r TenderRepository) ClaimScheduled(ctx context.Context, dto repositories.ClaimScheduledTendersDTO) ([]domain.ID, error) {
+	query := `SELECT id FROM tender
+		WHERE status = $1 AND publish_at <= $3
+		ORDER BY publish_at, id
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED`
+
+	return r.claim(ctx, query, domain.TenderCreatedStatus, dto.Limit, dto.Now)
+}
+
+// claim блокирует выбранные query строки до конца транзакции, поэтому несколько экземпляров
+// приложения не обработают один тендер дважды
+func (r TenderRepository) claim(ctx context.Context, query string, args ...interface{}) ([]domain.ID, error) {
+	rows, err := r.client.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]domain.ID, 0)
+
+	for rows.Next() {
+		var id domain.ID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
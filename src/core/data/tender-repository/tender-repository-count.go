@@ -0,0 +1,19 @@
+package tender_repository
+
+import (
+	"context"
+	"tms/src/core/services/repositories"
+	"tms/src/pkg/pg"
+)
+
+func (r TenderRepository) Count(ctx context.Context, dto repositories.GetTendersListDTO) (int, error) {
+	q := pg.NewQuery(`SELECT COUNT(*) FROM tender WHERE 1=1`)
+	filter(q, dto)
+
+	var count int
+	if err := r.client.QueryRow(ctx, q.SQL(), q.Args()...).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
@@ -2,87 +2,56 @@ package tender_repository
 
 import (
 	"context"
-	"fmt"
 	"tms/src/core/domain"
 	"tms/src/core/services/repositories"
+	"tms/src/pkg/pg"
 )
 
 func (r TenderRepository) GetList(ctx context.Context, dto repositories.GetTendersListDTO) ([]domain.Tender, error) {
-	query := `SELECT id, name, description, service_type, status, organization_id, version FROM tender WHERE 1=1`
-	args := make([]interface{}, 0)
-	i := 1
-
-	if dto.OrganizationID != nil {
-		query += fmt.Sprintf(` AND organization_id = $%d`, i)
-		args = append(args, dto.OrganizationID)
-		i++
-	}
-
-	if dto.ServiceType != nil {
-		query += fmt.Sprintf(` AND service_type = $%d`, i)
-		args = append(args, dto.ServiceType)
-		i++
-	}
-
-	if dto.Status != nil {
-		query += fmt.Sprintf(` AND status = $%d`, i)
-		args = append(args, dto.Status)
-		i++
-	}
-
-	if dto.Limit != nil {
-		query += fmt.Sprintf(` LIMIT $%d`, i)
-		args = append(args, dto.Limit)
-		i++
-	}
-
-	if dto.Offset != nil {
-		query += fmt.Sprintf(` OFFSET $%d`, i)
-		args = append(args, dto.Offset)
-		i++
+	q := pg.NewQuery(`SELECT id, name, description, service_type, status, organization_id, version, created_at, updated_at, editor_id, edited_at, submission_deadline, evaluation_deadline, publish_at, budget_amount, budget_currency FROM tender WHERE 1=1`)
+	filter(q, dto)
+	if err := sortColumns.Paginate(q, dto.Sort, dto.After, dto.Limit, dto.Offset); err != nil {
+		return nil, err
 	}
 
-	rows, err := r.client.Query(ctx, query, args...)
+	rows, err := r.client.Query(ctx, q.SQL(), q.Args()...)
 
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	tenders := make([]domain.Tender, 0)
+	var (
+		tenders = make([]domain.Tender, 0)
+		ids     []domain.ID
+	)
 
 	for rows.Next() {
-		var tender domain.Tender
-
-		err := rows.Scan(&tender.ID, &tender.Name, &tender.Description, &tender.ServiceType, &tender.Status, &tender.OrganizationID, &tender.Version)
-
-		if err != nil {
-			return nil, err
-		}
-
-		query = `SELECT name, description, service_type, version, created_at FROM tender_snapshot WHERE tender_id = $1`
+		var (
+			tender domain.Tender
+			budget nullableMoney
+		)
 
-		snapshotRows, err := r.client.Query(ctx, query, tender.ID)
+		err := rows.Scan(&tender.ID, &tender.Name, &tender.Description, &tender.ServiceType, &tender.Status, &tender.OrganizationID, &tender.Version, &tender.CreatedAt, &tender.UpdatedAt, &tender.EditorID, &tender.EditedAt, &tender.SubmissionDeadline, &tender.EvaluationDeadline, &tender.PublishAt, &budget.Amount, &budget.Currency)
 
 		if err != nil {
 			return nil, err
 		}
-		defer snapshotRows.Close()
-
-		snapshots := make([]domain.TenderSnapshot, 0)
-
-		for snapshotRows.Next() {
-			var snapshot domain.TenderSnapshot
-
-			err := snapshotRows.Scan(&snapshot.Name, &snapshot.Description, &snapshot.ServiceType, &snapshot.Version, &snapshot.CreatedAt)
-
-			if err != nil {
-				return nil, err
-			}
-			snapshots = append(snapshots, snapshot)
-		}
-		tender.Snapshots = snapshots
+		tender.Budget = budget.Money()
 		tenders = append(tenders, tender)
+		ids = append(ids, tender.ID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	snapshots, err := r.snapshots(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range tenders {
+		tenders[i].Snapshots = snapshots[tenders[i].ID]
 	}
 
 	return tenders, nil
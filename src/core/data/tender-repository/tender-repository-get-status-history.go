@@ -0,0 +1,32 @@
+package tender_repository
+
+import (
+	"context"
+	"tms/src/core/domain"
+)
+
+func (r TenderRepository) GetStatusHistory(ctx context.Context, tenderID domain.ID) ([]domain.TenderStatusChange, error) {
+	query := `SELECT id, COALESCE(from_status, ''), to_status, editor_id, reason, created_at FROM tender_status_history
+		WHERE tender_id = $1 ORDER BY created_at, id`
+
+	rows, err := r.client.Query(ctx, query, tenderID)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	history := []domain.TenderStatusChange{}
+
+	for rows.Next() {
+		var c domain.TenderStatusChange
+
+		if err := rows.Scan(&c.ID, &c.From, &c.To, &c.EditorID, &c.Reason, &c.CreatedAt); err != nil {
+			return nil, err
+		}
+
+		history = append(history, c)
+	}
+
+	return history, rows.Err()
+}
@@ -10,7 +10,7 @@ import (
 )
 
 func (r TenderRepository) Get(ctx context.Context, dto repositories.GetTenderDTO) (*domain.Tender, error) {
-	query := `SELECT id, name, description, service_type, status, organization_id, version FROM tender WHERE id = $1`
+	query := `SELECT id, name, description, service_type, status, organization_id, version, created_at, updated_at, editor_id, edited_at, submission_deadline, evaluation_deadline, publish_at, budget_amount, budget_currency FROM tender WHERE id = $1`
 	args := []interface{}{dto.ID}
 	i := 2
 
@@ -22,17 +22,21 @@ func (r TenderRepository) Get(ctx context.Context, dto repositories.GetTenderDTO
 
 	row := r.client.QueryRow(ctx, query, args...)
 
-	var tender domain.Tender
+	var (
+		tender domain.Tender
+		budget nullableMoney
+	)
 
-	err := row.Scan(&tender.ID, &tender.Name, &tender.Description, &tender.ServiceType, &tender.Status, &tender.OrganizationID, &tender.Version)
+	err := row.Scan(&tender.ID, &tender.Name, &tender.Description, &tender.ServiceType, &tender.Status, &tender.OrganizationID, &tender.Version, &tender.CreatedAt, &tender.UpdatedAt, &tender.EditorID, &tender.EditedAt, &tender.SubmissionDeadline, &tender.EvaluationDeadline, &tender.PublishAt, &budget.Amount, &budget.Currency)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, errors.Wrap(domain.ErrNotFound, "tender not found")
 		}
 		return nil, err
 	}
+	tender.Budget = budget.Money()
 
-	query = `SELECT name, description, service_type, version, created_at FROM tender_snapshot WHERE tender_id = $1`
+	query = `SELECT name, description, service_type, budget_amount, budget_currency, version, created_at, editor_id FROM tender_snapshot WHERE tender_id = $1`
 
 	snapshotRows, err := r.client.Query(ctx, query, tender.ID)
 	if err != nil {
@@ -43,12 +47,16 @@ func (r TenderRepository) Get(ctx context.Context, dto repositories.GetTenderDTO
 	snapshots := make([]domain.TenderSnapshot, 0)
 
 	for snapshotRows.Next() {
-		var snapshot domain.TenderSnapshot
+		var (
+			snapshot       domain.TenderSnapshot
+			snapshotBudget nullableMoney
+		)
 
-		err := snapshotRows.Scan(&snapshot.Name, &snapshot.Description, &snapshot.ServiceType, &snapshot.Version, &snapshot.CreatedAt)
+		err := snapshotRows.Scan(&snapshot.Name, &snapshot.Description, &snapshot.ServiceType, &snapshotBudget.Amount, &snapshotBudget.Currency, &snapshot.Version, &snapshot.CreatedAt, &snapshot.EditorID)
 		if err != nil {
 			return nil, err
 		}
+		snapshot.Budget = snapshotBudget.Money()
 		snapshots = append(snapshots, snapshot)
 	}
 	tender.Snapshots = snapshots
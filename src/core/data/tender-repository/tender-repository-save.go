@@ -13,11 +13,15 @@ func (r TenderRepository) Save(ctx context.Context, tender domain.Tender) error
 
 		deleteTenderSnapshotsQuery = `DELETE FROM tender_snapshot WHERE tender_id=$1`
 
-		createTenderQuery = `INSERT INTO tender(id, name, description, service_type, status, organization_id, version, created_at) 
-			VALUES ($1, $2, $3, $4, $5, $6, $7, $8);`
+		createTenderQuery = `INSERT INTO tender(id, name, description, service_type, status, organization_id, version, created_at, updated_at, editor_id, edited_at,
+			submission_deadline, evaluation_deadline, publish_at, budget_amount, budget_currency) 
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16);`
 
-		createTenderSnapshotQuery = `INSERT INTO tender_snapshot(id, tender_id, name, description, service_type, version, created_at)
-			VALUES ($1, $2, $3, $4, $5, $6, $7);`
+		createTenderSnapshotQuery = `INSERT INTO tender_snapshot(id, tender_id, name, description, service_type, budget_amount, budget_currency, version, created_at, editor_id)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);`
+
+		createTenderStatusChangeQuery = `INSERT INTO tender_status_history(id, tender_id, from_status, to_status, editor_id, reason, created_at)
+			VALUES ($1, $2, NULLIF($3, ''), $4, $5, $6, $7) ON CONFLICT (id) DO NOTHING;`
 	)
 
 	tx, err := r.client.BeginTx(ctx, pgx.TxOptions{})
@@ -39,16 +43,32 @@ func (r TenderRepository) Save(ctx context.Context, tender domain.Tender) error
 		return err
 	}
 
+	budget := newNullableMoney(tender.Budget)
+
 	_, err = tx.Exec(ctx, createTenderQuery, tender.ID, tender.Name, tender.Description, tender.ServiceType,
-		tender.Status, tender.OrganizationID, tender.Version, tender.CreatedAt)
+		tender.Status, tender.OrganizationID, tender.Version, tender.CreatedAt, tender.UpdatedAt, tender.EditorID, tender.EditedAt,
+		tender.SubmissionDeadline, tender.EvaluationDeadline, tender.PublishAt,
+		budget.Amount, budget.Currency)
 
 	if err != nil {
 		return err
 	}
 
 	for _, snapshot := range tender.Snapshots {
+		snapshotBudget := newNullableMoney(snapshot.Budget)
+
 		_, err = tx.Exec(ctx, createTenderSnapshotQuery, snapshot.ID, tender.ID, snapshot.Name, snapshot.Description,
-			snapshot.ServiceType, snapshot.Version, snapshot.CreatedAt)
+			snapshot.ServiceType, snapshotBudget.Amount, snapshotBudget.Currency,
+			snapshot.Version, snapshot.CreatedAt, snapshot.EditorID)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, change := range tender.StatusChanges {
+		_, err = tx.Exec(ctx, createTenderStatusChangeQuery, change.ID, tender.ID, change.From, change.To,
+			change.EditorID, change.Reason, change.CreatedAt)
 
 		if err != nil {
 			return err
@@ -0,0 +1,68 @@
+package tender_repository
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	list_query "tms/src/core/data/list-query"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+	"tms/src/pkg/pg"
+)
+
+func (r TenderRepository) Search(ctx context.Context, dto repositories.GetTendersListDTO) ([]repositories.TenderSearchResult, error) {
+	if dto.Query == nil {
+		return nil, errors.Wrap(domain.ErrValidation, "search query is required")
+	}
+
+	q := pg.NewQuery(`SELECT id, name, description, service_type, status, organization_id, version, created_at, updated_at, editor_id, edited_at, submission_deadline, evaluation_deadline, publish_at, budget_amount, budget_currency, ` + list_query.RankColumn + `,
+		` + list_query.Headline("name", "HighlightAll=true") + `,
+		` + list_query.Headline("description", "MaxWords=35, MinWords=15") + `
+		FROM tender WHERE 1=1`)
+	filter(q, dto)
+	if err := sortColumns.Paginate(q, dto.Sort, dto.After, dto.Limit, dto.Offset); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.client.Query(ctx, q.SQL(), q.Args()...)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		results = make([]repositories.TenderSearchResult, 0)
+		ids     []domain.ID
+	)
+
+	for rows.Next() {
+		var (
+			tender domain.Tender
+			budget nullableMoney
+			match  repositories.SearchMatch
+		)
+
+		err := rows.Scan(&tender.ID, &tender.Name, &tender.Description, &tender.ServiceType, &tender.Status, &tender.OrganizationID, &tender.Version, &tender.CreatedAt, &tender.UpdatedAt, &tender.EditorID, &tender.EditedAt, &tender.SubmissionDeadline, &tender.EvaluationDeadline, &tender.PublishAt, &budget.Amount, &budget.Currency, &match.Rank, &match.Name, &match.Description)
+
+		if err != nil {
+			return nil, err
+		}
+		tender.Budget = budget.Money()
+		results = append(results, repositories.TenderSearchResult{Tender: tender, Search: &match})
+		ids = append(ids, tender.ID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	snapshots, err := r.snapshots(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range results {
+		results[i].Tender.Snapshots = snapshots[results[i].Tender.ID]
+	}
+
+	return results, nil
+}
@@ -0,0 +1,42 @@
+package tender_repository
+
+import (
+	"context"
+	"tms/src/core/domain"
+	"tms/src/pkg/pg"
+)
+
+// snapshots загружает снимки тендеров с идентификаторами ids одним запросом.
+// Вызывается после закрытия строк основного запроса: в транзакции соединение не может читать два результата сразу
+func (r TenderRepository) snapshots(ctx context.Context, ids []domain.ID) (map[domain.ID][]domain.TenderSnapshot, error) {
+	query := `SELECT tender_id, name, description, service_type, budget_amount, budget_currency, version, created_at, editor_id FROM tender_snapshot
+		WHERE tender_id = ANY($1) ORDER BY tender_id, version`
+
+	rows, err := r.client.Query(ctx, query, pg.StringArray(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	snapshots := make(map[domain.ID][]domain.TenderSnapshot, len(ids))
+	for _, id := range ids {
+		snapshots[id] = make([]domain.TenderSnapshot, 0)
+	}
+
+	for rows.Next() {
+		var (
+			tenderID domain.ID
+			snapshot domain.TenderSnapshot
+			budget   nullableMoney
+		)
+
+		err := rows.Scan(&tenderID, &snapshot.Name, &snapshot.Description, &snapshot.ServiceType, &budget.Amount, &budget.Currency, &snapshot.Version, &snapshot.CreatedAt, &snapshot.EditorID)
+		if err != nil {
+			return nil, err
+		}
+		snapshot.Budget = budget.Money()
+		snapshots[tenderID] = append(snapshots[tenderID], snapshot)
+	}
+
+	return snapshots, rows.Err()
+}
@@ -1,6 +1,8 @@
 package tender_repository
 
 import (
+	list_query "tms/src/core/data/list-query"
+	"tms/src/core/domain"
 	"tms/src/core/services/repositories"
 	"tms/src/pkg/pg"
 )
@@ -14,3 +16,43 @@ func New(client pg.Client) repositories.TenderRepository {
 		client: client,
 	}
 }
+
+// nullableMoney Колонки необязательной суммы, обе содержат NULL, если сумма не задана
+type nullableMoney struct {
+	Amount   *int64
+	Currency *domain.Currency
+}
+
+func newNullableMoney(money *domain.Money) nullableMoney {
+	if money == nil {
+		return nullableMoney{}
+	}
+	return nullableMoney{Amount: &money.Amount, Currency: &money.Currency}
+}
+
+func (m nullableMoney) Money() *domain.Money {
+	if m.Amount == nil || m.Currency == nil {
+		return nil
+	}
+	return &domain.Money{Amount: *m.Amount, Currency: *m.Currency}
+}
+
+// filter добавляет к запросу условия WHERE по фильтрам dto
+func filter(q *pg.Query, dto repositories.GetTendersListDTO) {
+	list_query.Search(q, dto.Query)
+	list_query.Any(q, "id", dto.IDs)
+	list_query.Any(q, "organization_id", dto.OrganizationIDs)
+	list_query.Any(q, "service_type", dto.ServiceTypes)
+	list_query.Any(q, "status", dto.Statuses)
+	list_query.Amount(q, "budget", dto.Budget)
+	list_query.Time(q, dto.TimeFilter)
+}
+
+// sortColumns Колонки tender, соответствующие полям сортировки
+var sortColumns = list_query.SortColumns{
+	repositories.SortByName:      "name",
+	repositories.SortByCreatedAt: "created_at",
+	repositories.SortByVersion:   "version",
+	repositories.SortByRelevance: list_query.RankColumn,
+	repositories.SortByBudget:    "COALESCE(budget_amount, 0)",
+}
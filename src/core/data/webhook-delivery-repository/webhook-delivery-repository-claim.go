@@ -0,0 +1,26 @@
+package webhook_delivery_repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+func (r WebhookDeliveryRepository) Claim(ctx context.Context, dto repositories.ClaimWebhookDeliveriesDTO) ([]domain.WebhookDelivery, error) {
+	query := `WITH claimed AS (
+			UPDATE webhook_delivery SET next_attempt_at = CURRENT_TIMESTAMP + make_interval(secs => $3), lock_token = $4
+			WHERE id IN (
+				SELECT id FROM webhook_delivery
+				WHERE status = $1 AND next_attempt_at <= CURRENT_TIMESTAMP
+				ORDER BY next_attempt_at, id
+				LIMIT $2
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING *
+		)
+		SELECT id, webhook_id, event_id, event_name, payload, status, attempts, next_attempt_at,
+			last_error, response_status, created_at, delivered_at, lock_token FROM claimed ORDER BY created_at, id`
+
+	return r.query(ctx, query, domain.WebhookDeliveryPendingStatus, dto.Limit, dto.Lease.Seconds(), uuid.NewString())
+}
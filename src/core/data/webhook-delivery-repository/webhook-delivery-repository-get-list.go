@@ -0,0 +1,56 @@
+package webhook_delivery_repository
+
+import (
+	"context"
+	"fmt"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+func (r WebhookDeliveryRepository) GetList(ctx context.Context, dto repositories.GetWebhookDeliveriesListDTO) ([]domain.WebhookDelivery, error) {
+	query := selectDeliveryQuery + ` WHERE webhook_id = $1`
+	args := []interface{}{dto.WebhookID}
+	i := 2
+
+	if dto.Status != nil {
+		query += fmt.Sprintf(` AND status = $%d`, i)
+		args = append(args, *dto.Status)
+		i++
+	}
+
+	query += ` ORDER BY created_at DESC, id DESC`
+
+	if dto.Limit != nil {
+		query += fmt.Sprintf(` LIMIT $%d`, i)
+		args = append(args, dto.Limit)
+		i++
+	}
+
+	if dto.Offset != nil {
+		query += fmt.Sprintf(` OFFSET $%d`, i)
+		args = append(args, dto.Offset)
+		i++
+	}
+
+	return r.query(ctx, query, args...)
+}
+
+func (r WebhookDeliveryRepository) query(ctx context.Context, query string, args ...interface{}) ([]domain.WebhookDelivery, error) {
+	rows, err := r.client.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	deliveries := make([]domain.WebhookDelivery, 0)
+
+	for rows.Next() {
+		delivery, err := scanDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, *delivery)
+	}
+
+	return deliveries, rows.Err()
+}
@@ -0,0 +1,20 @@
+package webhook_delivery_repository
+
+import (
+	"context"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+	"tms/src/core/domain"
+)
+
+func (r WebhookDeliveryRepository) Get(ctx context.Context, id domain.ID) (*domain.WebhookDelivery, error) {
+	delivery, err := scanDelivery(r.client.QueryRow(ctx, selectDeliveryQuery+` WHERE id = $1`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.Wrap(domain.ErrNotFound, "webhook delivery not found")
+		}
+		return nil, err
+	}
+
+	return delivery, nil
+}
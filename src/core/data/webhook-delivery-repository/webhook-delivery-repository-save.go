@@ -0,0 +1,48 @@
+package webhook_delivery_repository
+
+import (
+	"context"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+const insertDeliveryQuery = `INSERT INTO webhook_delivery(id, webhook_id, event_id, event_name, payload, status, attempts,
+	next_attempt_at, last_error, response_status, created_at, delivered_at, lock_token)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`
+
+func (r WebhookDeliveryRepository) Append(ctx context.Context, deliveries ...domain.WebhookDelivery) error {
+	for _, d := range deliveries {
+		if _, err := r.exec(ctx, insertDeliveryQuery+` ON CONFLICT (webhook_id, event_id) DO NOTHING`, d); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r WebhookDeliveryRepository) Save(ctx context.Context, delivery domain.WebhookDelivery) error {
+	query := insertDeliveryQuery + ` ON CONFLICT (id) DO UPDATE SET status = EXCLUDED.status, attempts = EXCLUDED.attempts,
+		next_attempt_at = EXCLUDED.next_attempt_at, last_error = EXCLUDED.last_error,
+		response_status = EXCLUDED.response_status, delivered_at = EXCLUDED.delivered_at
+		WHERE webhook_delivery.lock_token IS NOT DISTINCT FROM EXCLUDED.lock_token`
+
+	n, err := r.exec(ctx, query, delivery)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return repositories.ErrLeaseLost
+	}
+
+	return nil
+}
+
+// exec выполняет query с полями d и возвращает кол-во затронутых строк
+func (r WebhookDeliveryRepository) exec(ctx context.Context, query string, d domain.WebhookDelivery) (int64, error) {
+	tag, err := r.client.Exec(ctx, query, d.ID, d.WebhookID, d.EventID, d.EventName, string(d.Payload), d.Status,
+		d.Attempts, d.NextAttemptAt, d.LastError, d.ResponseStatus, d.CreatedAt, d.DeliveredAt, d.LockToken)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,40 @@
+package webhook_delivery_repository
+
+import (
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+	"tms/src/pkg/pg"
+)
+
+type WebhookDeliveryRepository struct {
+	client pg.Client
+}
+
+func New(client pg.Client) repositories.WebhookDeliveryRepository {
+	return WebhookDeliveryRepository{
+		client: client,
+	}
+}
+
+const selectDeliveryQuery = `SELECT id, webhook_id, event_id, event_name, payload, status, attempts, next_attempt_at,
+	last_error, response_status, created_at, delivered_at, lock_token FROM webhook_delivery`
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanDelivery(row scanner) (*domain.WebhookDelivery, error) {
+	var (
+		d       domain.WebhookDelivery
+		payload []byte
+	)
+
+	err := row.Scan(&d.ID, &d.WebhookID, &d.EventID, &d.EventName, &payload, &d.Status, &d.Attempts, &d.NextAttemptAt,
+		&d.LastError, &d.ResponseStatus, &d.CreatedAt, &d.DeliveredAt, &d.LockToken)
+	if err != nil {
+		return nil, err
+	}
+	d.Payload = payload
+
+	return &d, nil
+}
@@ -0,0 +1,11 @@
+package webhook_repository
+
+import (
+	"context"
+	"tms/src/core/domain"
+)
+
+func (r WebhookRepository) Delete(ctx context.Context, id domain.ID) error {
+	_, err := r.client.Exec(ctx, `DELETE FROM webhook WHERE id = $1`, id)
+	return err
+}
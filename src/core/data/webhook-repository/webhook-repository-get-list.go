@@ -0,0 +1,37 @@
+package webhook_repository
+
+import (
+	"context"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+func (r WebhookRepository) GetList(ctx context.Context, dto repositories.GetWebhooksListDTO) ([]domain.Webhook, error) {
+	query := selectWebhookQuery + ` WHERE organization_id = $1`
+	args := []interface{}{dto.OrganizationID}
+
+	if dto.Event != nil {
+		query += ` AND $2 = ANY(events)`
+		args = append(args, string(*dto.Event))
+	}
+
+	query += ` ORDER BY created_at, id`
+
+	rows, err := r.client.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	webhooks := make([]domain.Webhook, 0)
+
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, *webhook)
+	}
+
+	return webhooks, rows.Err()
+}
@@ -0,0 +1,20 @@
+package webhook_repository
+
+import (
+	"context"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+	"tms/src/core/domain"
+)
+
+func (r WebhookRepository) Get(ctx context.Context, id domain.ID) (*domain.Webhook, error) {
+	webhook, err := scanWebhook(r.client.QueryRow(ctx, selectWebhookQuery+` WHERE id = $1`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.Wrap(domain.ErrNotFound, "webhook not found")
+		}
+		return nil, err
+	}
+
+	return webhook, nil
+}
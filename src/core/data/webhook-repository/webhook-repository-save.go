@@ -0,0 +1,17 @@
+package webhook_repository
+
+import (
+	"context"
+	"tms/src/core/domain"
+	"tms/src/pkg/pg"
+)
+
+func (r WebhookRepository) Save(ctx context.Context, webhook domain.Webhook) error {
+	query := `INSERT INTO webhook(id, organization_id, url, events, secret, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (id) DO UPDATE SET url = EXCLUDED.url, events = EXCLUDED.events, secret = EXCLUDED.secret, updated_at = EXCLUDED.updated_at`
+
+	_, err := r.client.Exec(ctx, query, webhook.ID, webhook.OrganizationID, webhook.URL, pg.StringArray(webhook.Events), webhook.Secret,
+		webhook.CreatedAt, webhook.UpdatedAt)
+	return err
+}
@@ -0,0 +1,42 @@
+package webhook_repository
+
+import (
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+	"tms/src/pkg/pg"
+)
+
+type WebhookRepository struct {
+	client pg.Client
+}
+
+func New(client pg.Client) repositories.WebhookRepository {
+	return WebhookRepository{
+		client: client,
+	}
+}
+
+const selectWebhookQuery = `SELECT id, organization_id, url, events, secret, created_at, updated_at FROM webhook`
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanWebhook(row scanner) (*domain.Webhook, error) {
+	var (
+		w      domain.Webhook
+		events []string
+	)
+
+	err := row.Scan(&w.ID, &w.OrganizationID, &w.URL, &events, &w.Secret, &w.CreatedAt, &w.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	w.Events = make([]domain.EventName, 0, len(events))
+	for _, e := range events {
+		w.Events = append(w.Events, domain.EventName(e))
+	}
+
+	return &w, nil
+}
@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"encoding/json"
+	"github.com/pkg/errors"
+	"time"
+)
+
+// AuditAction Действие, записанное в журнал аудита
+type AuditAction string
+
+const (
+	AuditCreateAction       AuditAction = "create"
+	AuditEditAction         AuditAction = "edit"
+	AuditRollbackAction     AuditAction = "rollback"
+	AuditStatusChangeAction AuditAction = "status"
+	AuditDecisionAction     AuditAction = "decision"
+	AuditScheduleAction     AuditAction = "schedule"
+	AuditCriteriaAction     AuditAction = "criteria"
+	AuditScoreAction        AuditAction = "score"
+	AuditDeleteAction       AuditAction = "delete"
+)
+
+func NewAuditAction(str string) (AuditAction, error) {
+	switch str {
+	case string(AuditCreateAction), string(AuditEditAction), string(AuditRollbackAction),
+		string(AuditStatusChangeAction), string(AuditDecisionAction), string(AuditScheduleAction),
+		string(AuditCriteriaAction), string(AuditScoreAction), string(AuditDeleteAction):
+		return AuditAction(str), nil
+	}
+	return "", errors.Wrapf(ErrValidation, "invalid audit action - '%s'", str)
+}
+
+// AuditEntityType Тип сущности, к которой относится запись журнала аудита
+type AuditEntityType string
+
+const (
+	AuditTenderEntity  AuditEntityType = "tender"
+	AuditBidEntity     AuditEntityType = "bid"
+	AuditWebhookEntity AuditEntityType = "webhook"
+	// AuditSavedSearchEntity сохраненный поиск относится к организации, за которую отвечает его владелец
+	AuditSavedSearchEntity  AuditEntityType = "saved_search"
+	AuditExchangeRateEntity AuditEntityType = "exchange_rate"
+)
+
+func NewAuditEntityType(str string) (AuditEntityType, error) {
+	switch str {
+	case string(AuditTenderEntity), string(AuditBidEntity), string(AuditWebhookEntity), string(AuditSavedSearchEntity),
+		string(AuditExchangeRateEntity):
+		return AuditEntityType(str), nil
+	}
+	return "", errors.Wrapf(ErrValidation, "invalid audit entity type - '%s'", str)
+}
+
+// AuditEntry Запись журнала аудита. Записи только добавляются и никогда не изменяются
+type AuditEntry struct {
+	ID             ID              `json:"id"`
+	Action         AuditAction     `json:"action"`
+	ActorID        ID              `json:"actorId"`
+	OrganizationID ID              `json:"organizationId"`
+	EntityType     AuditEntityType `json:"entityType"`
+	EntityID       ID              `json:"entityId"`
+	// Before и After состояние сущности до и после изменения, Before отсутствует при создании
+	Before    json.RawMessage `json:"before,omitempty"`
+	After     json.RawMessage `json:"after"`
+	RequestID string          `json:"requestId,omitempty"`
+	CreatedAt time.Time       `json:"createdAt"`
+}
+
+func NewAuditEntry(
+	action AuditAction,
+	actorID, organizationID ID,
+	entityType AuditEntityType,
+	entityID ID,
+	before, after interface{},
+	requestID string,
+) (*AuditEntry, error) {
+	var b json.RawMessage
+	if before != nil {
+		raw, err := json.Marshal(before)
+		if err != nil {
+			return nil, errors.Wrap(err, "marshal audit before payload")
+		}
+		b = raw
+	}
+
+	a, err := json.Marshal(after)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal audit after payload")
+	}
+
+	return &AuditEntry{
+		ID:             NewID(),
+		Action:         action,
+		ActorID:        actorID,
+		OrganizationID: organizationID,
+		EntityType:     entityType,
+		EntityID:       entityID,
+		Before:         b,
+		After:          a,
+		RequestID:      requestID,
+		CreatedAt:      time.Now(),
+	}, nil
+}
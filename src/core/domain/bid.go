@@ -90,10 +90,14 @@ func NewBidVersion(n int) BidVersion {
 
 // BidSnapshot Снимок состояния Bid
 type BidSnapshot struct {
-	ID          ID
-	Name        BidName
-	Description BidDescription
-	Version     BidVersion
+	ID          ID             `json:"-"`
+	Name        BidName        `json:"name"`
+	Description BidDescription `json:"description"`
+	Price       Money          `json:"price"`
+	Version     BidVersion     `json:"version"`
+	// CreatedAt и EditorID время создания версии и сотрудник, который ее создал
+	CreatedAt time.Time `json:"createdAt"`
+	EditorID  ID        `json:"editorId"`
 }
 
 // Bid Предложение
@@ -101,37 +105,76 @@ type Bid struct {
 	ID          ID             `json:"id"`
 	Name        BidName        `json:"name"`
 	Description BidDescription `json:"description"`
+	Price       Money          `json:"price"`
 	Status      BidStatus      `json:"status"`
 	TenderID    ID             `json:"-"`
 	AuthorType  BidAuthorType  `json:"authorType"`
 	AuthorID    ID             `json:"authorId"`
 	Version     BidVersion     `json:"version"`
 	CreatedAt   time.Time      `json:"createdAt"`
+	UpdatedAt   time.Time      `json:"updatedAt"`
 	Snapshots   []BidSnapshot  `json:"-"`
+	// EditorID и EditedAt сотрудник, создавший текущую версию, и время ее создания
+	EditorID ID        `json:"-"`
+	EditedAt time.Time `json:"-"`
+	// StatusChanges изменения статуса, еще не записанные в журнал
+	StatusChanges []BidStatusChange `json:"-"`
+	events
+}
+
+// touch отмечает момент последнего изменения Bid
+func (b *Bid) touch() {
+	b.UpdatedAt = time.Now()
 }
 
-func (b *Bid) ChangeStatus(status string) error {
+func (b *Bid) ChangeStatus(editor ID, status string, reason *string) error {
 	s, err := NewBidStatus(status)
 	if err != nil {
 		return err
 	}
+	r, err := NewStatusChangeReason(reason)
+	if err != nil {
+		return err
+	}
+	if s == b.Status {
+		return nil
+	}
+	b.StatusChanges = append(b.StatusChanges, newStatusChange(b.Status, s, editor, r))
+	b.record(BidStatusChanged{
+		EventMeta: newEventMeta(),
+		BidID:     b.ID,
+		TenderID:  b.TenderID,
+		From:      b.Status,
+		To:        s,
+		EditorID:  editor,
+		Reason:    r,
+	})
 	b.Status = s
+	b.touch()
 	return nil
 }
 
-func (b *Bid) takeSnapshot() {
+// takeSnapshot сохраняет текущую версию Bid в Snapshots и начинает новую версию, созданную editor
+func (b *Bid) takeSnapshot(editor ID) {
 	snapshot := BidSnapshot{
 		ID:          NewID(),
 		Name:        b.Name,
 		Description: b.Description,
+		Price:       b.Price,
 		Version:     b.Version,
+		CreatedAt:   b.EditedAt,
+		EditorID:    b.EditorID,
 	}
 	b.Snapshots = append(b.Snapshots, snapshot)
 	b.Version++
+	b.EditorID = editor
+	b.EditedAt = time.Now()
+	b.UpdatedAt = b.EditedAt
 }
 
-func (b *Bid) Edit(name, description *string) error {
-	b.takeSnapshot()
+// Edit изменяет переданные поля. Соответствие цены бюджету тендера проверяет вызывающий код
+func (b *Bid) Edit(editor ID, name, description *string, price *Money) error {
+	b.takeSnapshot(editor)
 
 	if name != nil {
 		bidName, err := NewBidName(*name)
@@ -149,10 +192,84 @@ func (b *Bid) Edit(name, description *string) error {
 		b.Description = bidDescription
 	}
 
+	if price != nil {
+		if err := price.validate(); err != nil {
+			return err
+		}
+		b.Price = *price
+	}
+
+	b.record(BidEdited{
+		EventMeta: newEventMeta(),
+		BidID:     b.ID,
+		TenderID:  b.TenderID,
+		Version:   b.Version,
+		EditorID:  editor,
+	})
+
 	return nil
 }
 
-func (b *Bid) Rollback(version int) error {
+// CurrentVersion возвращает текущее состояние Bid в виде снимка
+func (b Bid) CurrentVersion() BidSnapshot {
+	return BidSnapshot{
+		ID:          b.ID,
+		Name:        b.Name,
+		Description: b.Description,
+		Price:       b.Price,
+		Version:     b.Version,
+		CreatedAt:   b.EditedAt,
+		EditorID:    b.EditorID,
+	}
+}
+
+// Versions возвращает все версии Bid по возрастанию, включая текущую
+func (b Bid) Versions() []BidSnapshot {
+	versions := make([]BidSnapshot, 0, len(b.Snapshots)+1)
+	versions = append(versions, b.Snapshots...)
+	versions = append(versions, b.CurrentVersion())
+
+	slices.SortFunc(versions, func(x, y BidSnapshot) int {
+		return int(x.Version) - int(y.Version)
+	})
+
+	return versions
+}
+
+// FindVersion возвращает версию Bid с номером version
+func (b Bid) FindVersion(version int) (*BidSnapshot, error) {
+	v := NewBidVersion(version)
+
+	versions := b.Versions()
+
+	i := slices.IndexFunc(versions, func(s BidSnapshot) bool {
+		return s.Version == v
+	})
+
+	if i == -1 {
+		return nil, errors.Wrapf(ErrNotFound, "Bid version %d not found", v)
+	}
+
+	return &versions[i], nil
+}
+
+// DiffVersions сравнивает версии from и to
+func (b Bid) DiffVersions(from, to int) (*VersionDiff, error) {
+	x, err := b.FindVersion(from)
+	if err != nil {
+		return nil, err
+	}
+
+	y, err := b.FindVersion(to)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := x.Diff(*y)
+	return &diff, nil
+}
+
+func (b *Bid) Rollback(editor ID, version int) error {
 	v := NewBidVersion(version)
 
 	i := slices.IndexFunc(b.Snapshots, func(s BidSnapshot) bool {
@@ -165,20 +282,24 @@ func (b *Bid) Rollback(version int) error {
 
 	snapshot := b.Snapshots[i]
 
-	b.Snapshots = append(b.Snapshots, BidSnapshot{
-		ID:          b.ID,
-		Name:        b.Name,
-		Description: b.Description,
-		Version:     b.Version,
-	})
+	b.takeSnapshot(editor)
 	b.Name = snapshot.Name
 	b.Description = snapshot.Description
-	b.Version++
+	b.Price = snapshot.Price
+
+	b.record(BidRolledBack{
+		EventMeta:       newEventMeta(),
+		BidID:           b.ID,
+		TenderID:        b.TenderID,
+		Version:         b.Version,
+		RestoredVersion: snapshot.Version,
+		EditorID:        editor,
+	})
 
 	return nil
 }
 
-func NewBid(name, description, authorType string, tenderID, authorID ID) (*Bid, error) {
+func NewBid(name, description, authorType string, price Money, tenderID, authorID ID) (*Bid, error) {
 	id := NewID()
 
 	bidName, err := NewBidName(name)
@@ -191,6 +312,10 @@ func NewBid(name, description, authorType string, tenderID, authorID ID) (*Bid,
 		return nil, err
 	}
 
+	if err := price.validate(); err != nil {
+		return nil, err
+	}
+
 	status := BidCreatedStatus
 
 	bidAuthorType, err := NewBidAuthorType(authorType)
@@ -201,16 +326,32 @@ func NewBid(name, description, authorType string, tenderID, authorID ID) (*Bid,
 	version := NewBidVersion(1)
 	createdAt := time.Now()
 
-	return &Bid{
-		ID:          id,
-		Name:        bidName,
-		Description: bidDescription,
-		Status:      status,
-		TenderID:    tenderID,
-		AuthorType:  bidAuthorType,
-		AuthorID:    authorID,
-		Version:     version,
-		CreatedAt:   createdAt,
-		Snapshots:   make([]BidSnapshot, 0),
-	}, nil
+	bid := &Bid{
+		ID:            id,
+		Name:          bidName,
+		Description:   bidDescription,
+		Price:         price,
+		Status:        status,
+		TenderID:      tenderID,
+		AuthorType:    bidAuthorType,
+		AuthorID:      authorID,
+		Version:       version,
+		CreatedAt:     createdAt,
+		UpdatedAt:     createdAt,
+		Snapshots:     make([]BidSnapshot, 0),
+		EditorID:      authorID,
+		EditedAt:      createdAt,
+		StatusChanges: []BidStatusChange{newStatusChange("", status, authorID, nil)},
+	}
+
+	bid.record(BidSubmitted{
+		EventMeta:  newEventMeta(),
+		BidID:      id,
+		TenderID:   tenderID,
+		Name:       bidName,
+		AuthorType: bidAuthorType,
+		AuthorID:   authorID,
+	})
+
+	return bid, nil
 }
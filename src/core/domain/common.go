@@ -11,6 +11,9 @@ func NewID() ID {
 	return ID(uuid.New().String())
 }
 
+// SystemEditorID автор изменений, которые сервис выполняет сам, без участия сотрудника
+const SystemEditorID ID = "system"
+
 // Errors
 
 var (
@@ -33,6 +33,7 @@ type Decision struct {
 	BidID    ID
 	TenderID ID
 	Status   DecisionStatus
+	events
 }
 
 func NewDecision(bidDecisions []Decision, authorID, bidID, tenderID ID, status string) (*Decision, error) {
@@ -50,13 +51,24 @@ func NewDecision(bidDecisions []Decision, authorID, bidID, tenderID ID, status s
 		return nil, err
 	}
 
-	return &Decision{
+	decision := &Decision{
 		ID:       id,
 		AuthorID: authorID,
 		BidID:    bidID,
 		TenderID: tenderID,
 		Status:   s,
-	}, nil
+	}
+
+	decision.record(DecisionMade{
+		EventMeta:  newEventMeta(),
+		DecisionID: id,
+		BidID:      bidID,
+		TenderID:   tenderID,
+		AuthorID:   authorID,
+		Status:     s,
+	})
+
+	return decision, nil
 }
 
 func MakeFinalDecision(
@@ -66,13 +78,14 @@ func MakeFinalDecision(
 	tenderOwner OrganizationResponsible,
 	tender *Tender,
 	bid *Bid,
+	reason *string,
 ) {
 	if incomingDecision.Status == DecisionRejectedStatus {
-		bid.Status = BidCanceledStatus
+		_ = bid.ChangeStatus(incomingDecision.AuthorID, string(BidCanceledStatus), reason)
 		return
 	}
 
 	if quorumSize <= decisionsCount+1 {
-		_ = tender.ChangeStatus(tenderOwner, string(TenderClosedStatus))
+		_ = tender.ChangeStatus(tenderOwner, string(TenderClosedStatus), reason)
 	}
 }
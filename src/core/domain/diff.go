@@ -0,0 +1,59 @@
+package domain
+
+// FieldChange Изменение значения поля между двумя версиями
+type FieldChange struct {
+	Field string      `json:"field"`
+	Old   interface{} `json:"old"`
+	New   interface{} `json:"new"`
+}
+
+// VersionDiff Разница между двумя версиями объекта, Changes содержит только изменившиеся поля
+type VersionDiff struct {
+	From    int           `json:"from"`
+	To      int           `json:"to"`
+	Changes []FieldChange `json:"changes"`
+}
+
+func appendChange[T comparable](changes []FieldChange, field string, old, new T) []FieldChange {
+	if old == new {
+		return changes
+	}
+	return append(changes, FieldChange{Field: field, Old: old, New: new})
+}
+
+// appendOptionalChange то же, что и appendChange, для необязательных полей, nil означает отсутствие значения
+func appendOptionalChange[T comparable](changes []FieldChange, field string, old, new *T) []FieldChange {
+	if old == nil && new == nil || old != nil && new != nil && *old == *new {
+		return changes
+	}
+	return append(changes, FieldChange{Field: field, Old: old, New: new})
+}
+
+// Diff сравнивает версию s с версией other
+func (s TenderSnapshot) Diff(other TenderSnapshot) VersionDiff {
+	changes := make([]FieldChange, 0)
+	changes = appendChange(changes, "name", s.Name, other.Name)
+	changes = appendChange(changes, "description", s.Description, other.Description)
+	changes = appendChange(changes, "serviceType", s.ServiceType, other.ServiceType)
+	changes = appendOptionalChange(changes, "budget", s.Budget, other.Budget)
+
+	return VersionDiff{
+		From:    int(s.Version),
+		To:      int(other.Version),
+		Changes: changes,
+	}
+}
+
+// Diff сравнивает версию s с версией other
+func (s BidSnapshot) Diff(other BidSnapshot) VersionDiff {
+	changes := make([]FieldChange, 0)
+	changes = appendChange(changes, "name", s.Name, other.Name)
+	changes = appendChange(changes, "description", s.Description, other.Description)
+	changes = appendChange(changes, "price", s.Price, other.Price)
+
+	return VersionDiff{
+		From:    int(s.Version),
+		To:      int(other.Version),
+		Changes: changes,
+	}
+}
@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestTenderDiffVersions(t *testing.T) {
+	executor := OrganizationResponsible{OrganizationID: "org"}
+	tender, err := NewTender("Доставка", "Описание", string(TenderDeliveryServiceType), "org", nil, nil, nil, executor)
+	require.NoError(t, err)
+
+	name := "Доставка оборудования"
+	require.NoError(t, tender.Edit(executor, &name, nil, nil, nil, nil, nil))
+
+	diff, err := tender.DiffVersions(1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []FieldChange{{Field: "name", Old: TenderName("Доставка"), New: TenderName(name)}}, diff.Changes)
+
+	_, err = tender.DiffVersions(1, 5)
+	assert.True(t, errors.Is(errors.Cause(err), ErrNotFound))
+}
@@ -0,0 +1,66 @@
+package domain
+
+import "time"
+
+// EmailStatus Статус письма
+type EmailStatus string
+
+const (
+	EmailPendingStatus EmailStatus = "Pending"
+	EmailSentStatus    EmailStatus = "Sent"
+	EmailDeadStatus    EmailStatus = "Dead"
+)
+
+// Email Письмо сотруднику, ожидающее отправки или отправленное
+type Email struct {
+	ID          ID
+	RecipientID ID
+	// EventID событие, по которому создано письмо
+	EventID       ID
+	To            string
+	Subject       string
+	Body          string
+	Status        EmailStatus
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     *string
+	CreatedAt     time.Time
+	SentAt        *time.Time
+}
+
+func NewEmail(recipientID, eventID ID, to, subject, body string) Email {
+	now := time.Now()
+
+	return Email{
+		ID:            NewID(),
+		RecipientID:   recipientID,
+		EventID:       eventID,
+		To:            to,
+		Subject:       subject,
+		Body:          body,
+		Status:        EmailPendingStatus,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}
+}
+
+// Sent отмечает успешную отправку
+func (e *Email) Sent() {
+	now := time.Now()
+	e.Attempts++
+	e.Status = EmailSentStatus
+	e.LastError = nil
+	e.SentAt = &now
+}
+
+// Failed отмечает неудачную попытку. Следующая попытка назначается на nextAttemptAt,
+// после maxAttempts попыток письмо больше не отправляется
+func (e *Email) Failed(reason string, nextAttemptAt time.Time, maxAttempts int) {
+	e.Attempts++
+	e.LastError = &reason
+	e.NextAttemptAt = nextAttemptAt
+
+	if e.Attempts >= maxAttempts {
+		e.Status = EmailDeadStatus
+	}
+}
@@ -7,6 +7,8 @@ type Employee struct {
 	Username  string
 	FirstName *string
 	LastName  *string
+	// Email адрес для писем, nil если не указан
+	Email *string
 }
 
 func NewEmployee(username string, firstName, lastName *string) (Employee, error) {
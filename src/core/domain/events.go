@@ -0,0 +1,308 @@
+package domain
+
+import (
+	"encoding/json"
+	"github.com/pkg/errors"
+	"time"
+)
+
+// EventName Имя доменного события, по нему подписчики выбирают события
+type EventName string
+
+const (
+	TenderCreatedEvent       EventName = "TenderCreated"
+	TenderEditedEvent        EventName = "TenderEdited"
+	TenderRolledBackEvent    EventName = "TenderRolledBack"
+	TenderPublishedEvent     EventName = "TenderPublished"
+	TenderClosedEvent        EventName = "TenderClosed"
+	TenderStatusChangedEvent EventName = "TenderStatusChanged"
+	BidSubmittedEvent        EventName = "BidSubmitted"
+	BidEditedEvent           EventName = "BidEdited"
+	BidRolledBackEvent       EventName = "BidRolledBack"
+	BidPublishedEvent        EventName = "BidPublished"
+	BidCanceledEvent         EventName = "BidCanceled"
+	BidStatusChangedEvent    EventName = "BidStatusChanged"
+	DecisionMadeEvent        EventName = "DecisionMade"
+	TenderCriteriaSetEvent   EventName = "TenderCriteriaSet"
+	BidScoredEvent           EventName = "BidScored"
+)
+
+func NewEventName(str string) (EventName, error) {
+	switch EventName(str) {
+	case TenderCreatedEvent, TenderEditedEvent, TenderRolledBackEvent, TenderPublishedEvent, TenderClosedEvent,
+		TenderStatusChangedEvent, BidSubmittedEvent, BidEditedEvent, BidRolledBackEvent, BidPublishedEvent,
+		BidCanceledEvent, BidStatusChangedEvent, DecisionMadeEvent, TenderCriteriaSetEvent, BidScoredEvent:
+		return EventName(str), nil
+	}
+	return "", errors.Wrapf(ErrValidation, "unknown event - '%s'", str)
+}
+
+// Event Доменное событие, записанное агрегатом при изменении состояния
+type Event interface {
+	EventName() EventName
+	// AggregateID идентификатор агрегата, записавшего событие
+	AggregateID() ID
+	Meta() EventMeta
+}
+
+// EventMeta Общие поля доменных событий
+type EventMeta struct {
+	EventID    ID        `json:"eventId"`
+	OccurredAt time.Time `json:"occurredAt"`
+}
+
+func newEventMeta() EventMeta {
+	return EventMeta{
+		EventID:    NewID(),
+		OccurredAt: time.Now(),
+	}
+}
+
+func (m EventMeta) Meta() EventMeta {
+	return m
+}
+
+// events Записанные агрегатом события, которые еще не были разосланы
+type events struct {
+	pending []Event
+}
+
+func (e *events) record(event Event) {
+	e.pending = append(e.pending, event)
+}
+
+// PullEvents возвращает записанные события и очищает их, чтобы они не были разосланы повторно
+func (e *events) PullEvents() []Event {
+	pending := e.pending
+	e.pending = nil
+	return pending
+}
+
+type TenderCreated struct {
+	EventMeta
+	TenderID       ID                `json:"tenderId"`
+	OrganizationID ID                `json:"organizationId"`
+	Name           TenderName        `json:"name"`
+	ServiceType    TenderServiceType `json:"serviceType"`
+	CreatorID      ID                `json:"creatorId"`
+}
+
+func (e TenderCreated) EventName() EventName { return TenderCreatedEvent }
+
+func (e TenderCreated) AggregateID() ID { return e.TenderID }
+
+type TenderEdited struct {
+	EventMeta
+	TenderID       ID            `json:"tenderId"`
+	OrganizationID ID            `json:"organizationId"`
+	Version        TenderVersion `json:"version"`
+	EditorID       ID            `json:"editorId"`
+}
+
+func (e TenderEdited) EventName() EventName { return TenderEditedEvent }
+
+func (e TenderEdited) AggregateID() ID { return e.TenderID }
+
+type TenderRolledBack struct {
+	EventMeta
+	TenderID       ID            `json:"tenderId"`
+	OrganizationID ID            `json:"organizationId"`
+	Version        TenderVersion `json:"version"`
+	// RestoredVersion версия, состояние которой восстановлено
+	RestoredVersion TenderVersion `json:"restoredVersion"`
+	EditorID        ID            `json:"editorId"`
+}
+
+func (e TenderRolledBack) EventName() EventName { return TenderRolledBackEvent }
+
+func (e TenderRolledBack) AggregateID() ID { return e.TenderID }
+
+// TenderStatusChanged публикуется как TenderPublished или TenderClosed, если тендер перешел в эти статусы
+type TenderStatusChanged struct {
+	EventMeta
+	TenderID       ID           `json:"tenderId"`
+	OrganizationID ID           `json:"organizationId"`
+	From           TenderStatus `json:"from"`
+	To             TenderStatus `json:"to"`
+	EditorID       ID           `json:"editorId"`
+	Reason         *string      `json:"reason,omitempty"`
+}
+
+func (e TenderStatusChanged) EventName() EventName {
+	switch e.To {
+	case TenderPublishedStatus:
+		return TenderPublishedEvent
+	case TenderClosedStatus:
+		return TenderClosedEvent
+	}
+	return TenderStatusChangedEvent
+}
+
+func (e TenderStatusChanged) AggregateID() ID { return e.TenderID }
+
+type BidSubmitted struct {
+	EventMeta
+	BidID      ID            `json:"bidId"`
+	TenderID   ID            `json:"tenderId"`
+	Name       BidName       `json:"name"`
+	AuthorType BidAuthorType `json:"authorType"`
+	AuthorID   ID            `json:"authorId"`
+}
+
+func (e BidSubmitted) EventName() EventName { return BidSubmittedEvent }
+
+func (e BidSubmitted) AggregateID() ID { return e.BidID }
+
+type BidEdited struct {
+	EventMeta
+	BidID    ID         `json:"bidId"`
+	TenderID ID         `json:"tenderId"`
+	Version  BidVersion `json:"version"`
+	EditorID ID         `json:"editorId"`
+}
+
+func (e BidEdited) EventName() EventName { return BidEditedEvent }
+
+func (e BidEdited) AggregateID() ID { return e.BidID }
+
+type BidRolledBack struct {
+	EventMeta
+	BidID    ID         `json:"bidId"`
+	TenderID ID         `json:"tenderId"`
+	Version  BidVersion `json:"version"`
+	// RestoredVersion версия, состояние которой восстановлено
+	RestoredVersion BidVersion `json:"restoredVersion"`
+	EditorID        ID         `json:"editorId"`
+}
+
+func (e BidRolledBack) EventName() EventName { return BidRolledBackEvent }
+
+func (e BidRolledBack) AggregateID() ID { return e.BidID }
+
+// BidStatusChanged публикуется как BidPublished или BidCanceled, если предложение перешло в эти статусы
+type BidStatusChanged struct {
+	EventMeta
+	BidID    ID        `json:"bidId"`
+	TenderID ID        `json:"tenderId"`
+	From     BidStatus `json:"from"`
+	To       BidStatus `json:"to"`
+	EditorID ID        `json:"editorId"`
+	Reason   *string   `json:"reason,omitempty"`
+}
+
+func (e BidStatusChanged) EventName() EventName {
+	switch e.To {
+	case BidPublishedStatus:
+		return BidPublishedEvent
+	case BidCanceledStatus:
+		return BidCanceledEvent
+	}
+	return BidStatusChangedEvent
+}
+
+func (e BidStatusChanged) AggregateID() ID { return e.BidID }
+
+type DecisionMade struct {
+	EventMeta
+	DecisionID ID             `json:"decisionId"`
+	BidID      ID             `json:"bidId"`
+	TenderID   ID             `json:"tenderId"`
+	AuthorID   ID             `json:"authorId"`
+	Status     DecisionStatus `json:"status"`
+}
+
+func (e DecisionMade) EventName() EventName { return DecisionMadeEvent }
+
+func (e DecisionMade) AggregateID() ID { return e.DecisionID }
+
+type TenderCriteriaSet struct {
+	EventMeta
+	TenderID       ID              `json:"tenderId"`
+	OrganizationID ID              `json:"organizationId"`
+	Criteria       ScoringCriteria `json:"criteria"`
+	EditorID       ID              `json:"editorId"`
+}
+
+func (e TenderCriteriaSet) EventName() EventName { return TenderCriteriaSetEvent }
+
+func (e TenderCriteriaSet) AggregateID() ID { return e.TenderID }
+
+// BidScored оценки предложения видны только организации тендера, поэтому событие содержит ее идентификатор
+type BidScored struct {
+	EventMeta
+	BidID          ID         `json:"bidId"`
+	TenderID       ID         `json:"tenderId"`
+	OrganizationID ID         `json:"organizationId"`
+	EvaluatorID    ID         `json:"evaluatorId"`
+	Scores         []BidScore `json:"scores"`
+}
+
+func (e BidScored) EventName() EventName { return BidScoredEvent }
+
+func (e BidScored) AggregateID() ID { return e.BidID }
+
+// TenderOf возвращает тендер, к которому относится событие
+func TenderOf(event Event) ID {
+	switch e := event.(type) {
+	case TenderCreated:
+		return e.TenderID
+	case TenderEdited:
+		return e.TenderID
+	case TenderRolledBack:
+		return e.TenderID
+	case TenderStatusChanged:
+		return e.TenderID
+	case BidSubmitted:
+		return e.TenderID
+	case BidEdited:
+		return e.TenderID
+	case BidRolledBack:
+		return e.TenderID
+	case BidStatusChanged:
+		return e.TenderID
+	case DecisionMade:
+		return e.TenderID
+	case TenderCriteriaSet:
+		return e.TenderID
+	case BidScored:
+		return e.TenderID
+	}
+	return ""
+}
+
+// DecodeEvent восстанавливает событие с именем name из JSON payload
+func DecodeEvent(name EventName, payload []byte) (Event, error) {
+	switch name {
+	case TenderCreatedEvent:
+		return decodeEvent[TenderCreated](name, payload)
+	case TenderEditedEvent:
+		return decodeEvent[TenderEdited](name, payload)
+	case TenderRolledBackEvent:
+		return decodeEvent[TenderRolledBack](name, payload)
+	case TenderPublishedEvent, TenderClosedEvent, TenderStatusChangedEvent:
+		return decodeEvent[TenderStatusChanged](name, payload)
+	case BidSubmittedEvent:
+		return decodeEvent[BidSubmitted](name, payload)
+	case BidEditedEvent:
+		return decodeEvent[BidEdited](name, payload)
+	case BidRolledBackEvent:
+		return decodeEvent[BidRolledBack](name, payload)
+	case BidPublishedEvent, BidCanceledEvent, BidStatusChangedEvent:
+		return decodeEvent[BidStatusChanged](name, payload)
+	case DecisionMadeEvent:
+		return decodeEvent[DecisionMade](name, payload)
+	case TenderCriteriaSetEvent:
+		return decodeEvent[TenderCriteriaSet](name, payload)
+	case BidScoredEvent:
+		return decodeEvent[BidScored](name, payload)
+	}
+	return nil, errors.Wrapf(ErrValidation, "unknown event - '%s'", name)
+}
+
+func decodeEvent[E Event](name EventName, payload []byte) (Event, error) {
+	var event E
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, errors.Wrapf(err, "decode event %s", name)
+	}
+	return event, nil
+}
@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func eventNames(events []Event) []EventName {
+	names := make([]EventName, 0, len(events))
+	for _, e := range events {
+		names = append(names, e.EventName())
+	}
+	return names
+}
+
+func TestTenderEvents(t *testing.T) {
+	executor := OrganizationResponsible{OrganizationID: "org", UserID: "user"}
+	tender, err := NewTender("Доставка", "Описание", string(TenderDeliveryServiceType), "org", nil, nil, nil, executor)
+	require.NoError(t, err)
+
+	require.NoError(t, tender.ChangeStatus(executor, string(TenderPublishedStatus), nil))
+	// Повторная установка того же статуса ничего не меняет
+	require.NoError(t, tender.ChangeStatus(executor, string(TenderPublishedStatus), nil))
+
+	events := tender.PullEvents()
+	assert.Equal(t, []EventName{TenderCreatedEvent, TenderPublishedEvent}, eventNames(events))
+	assert.Equal(t, tender.ID, events[1].AggregateID())
+	assert.Empty(t, tender.PullEvents())
+}
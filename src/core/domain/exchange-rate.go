@@ -0,0 +1,160 @@
+package domain
+
+import (
+	"encoding/json"
+	"github.com/pkg/errors"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// rateScale Курс хранится в миллионных долях
+const rateScale = 1_000_000
+
+// maxRateDigits Максимальное кол-во цифр целой части курса
+const maxRateDigits = 9
+
+// ExchangeRateValue Кол-во единиц валюты To за единицу валюты From в миллионных долях
+type ExchangeRateValue int64
+
+// NewExchangeRateValue разбирает положительный курс вида "92.5" с точностью до 6 знаков после точки
+func NewExchangeRateValue(str string) (ExchangeRateValue, error) {
+	whole, frac, hasFrac := strings.Cut(str, ".")
+
+	if whole == "" || len(whole) > maxRateDigits || !isDigits(whole) {
+		return 0, errors.Wrapf(ErrValidation, "invalid exchange rate: %s", str)
+	}
+	if hasFrac && (frac == "" || len(frac) > 6 || !isDigits(frac)) {
+		return 0, errors.Wrapf(ErrValidation, "exchange rate must have at most 6 decimal places: %s", str)
+	}
+
+	units, _ := strconv.ParseInt(whole, 10, 64)
+	micros, _ := strconv.ParseInt((frac + "000000")[:6], 10, 64)
+	rate := units*rateScale + micros
+
+	if rate <= 0 {
+		return 0, errors.Wrap(ErrValidation, "exchange rate must be positive")
+	}
+
+	return ExchangeRateValue(rate), nil
+}
+
+func (v ExchangeRateValue) String() string {
+	str := strconv.FormatInt(int64(v)/rateScale, 10)
+	if frac := int64(v) % rateScale; frac != 0 {
+		str += "." + strings.TrimRight(strconv.FormatInt(rateScale+frac, 10)[1:], "0")
+	}
+	return str
+}
+
+// MarshalJSON Курс передается строкой, как и денежные суммы
+func (v ExchangeRateValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.String())
+}
+
+// dateLayout Формат даты начала действия курса
+const dateLayout = time.DateOnly
+
+// ExchangeRate Курс обмена From на To, действующий с EffectiveDate до следующего курса той же пары.
+// Курсы задает организация, и применяются они только к предложениям на ее тендеры.
+// Курсы не изменяются, ошибочный курс удаляется и создается заново
+type ExchangeRate struct {
+	ID             ID                `json:"id"`
+	OrganizationID ID                `json:"organizationId"`
+	From           Currency          `json:"from"`
+	To             Currency          `json:"to"`
+	Rate           ExchangeRateValue `json:"rate"`
+	// EffectiveDate дата без времени в UTC
+	EffectiveDate time.Time `json:"effectiveDate"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+func NewExchangeRate(from, to, rate, effectiveDate string, executor OrganizationResponsible) (*ExchangeRate, error) {
+	f, err := NewCurrency(from)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := NewCurrency(to)
+	if err != nil {
+		return nil, err
+	}
+
+	if f == t {
+		return nil, errors.Wrap(ErrValidation, "exchange rate currencies must differ")
+	}
+
+	r, err := NewExchangeRateValue(rate)
+	if err != nil {
+		return nil, err
+	}
+
+	date, err := ParseDate(effectiveDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExchangeRate{
+		ID:             NewID(),
+		OrganizationID: executor.OrganizationID,
+		From:           f,
+		To:             t,
+		Rate:           r,
+		EffectiveDate:  date,
+		CreatedAt:      time.Now(),
+	}, nil
+}
+
+// CheckAccess проверяет, что executor отвечает за организацию курса
+func (r ExchangeRate) CheckAccess(executor OrganizationResponsible) error {
+	if executor.OrganizationID != r.OrganizationID {
+		return errors.Wrap(ErrNoPermission, "Organization responsible has no access to ExchangeRate")
+	}
+	return nil
+}
+
+// ParseDate разбирает дату в формате YYYY-MM-DD
+func ParseDate(str string) (time.Time, error) {
+	date, err := time.Parse(dateLayout, str)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(ErrValidation, "date must be in YYYY-MM-DD format: %s", str)
+	}
+	return date, nil
+}
+
+// DateOf возвращает календарную дату момента t в UTC
+func DateOf(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// Convert переводит сумму из From в To с округлением до минимальной единицы валюты.
+// Все поддерживаемые валюты имеют по 100 минимальных единиц, поэтому курс применяется к ним напрямую
+func (r ExchangeRate) Convert(m Money) (Money, error) {
+	if m.Currency != r.From {
+		return Money{}, errors.Wrapf(ErrValidation, "cannot convert %s with %s/%s exchange rate", m.Currency, r.From, r.To)
+	}
+
+	// Произведение может не поместиться в int64, округление половины в большую сторону
+	amount := new(big.Int).Mul(big.NewInt(m.Amount), big.NewInt(int64(r.Rate)))
+	amount.Add(amount, big.NewInt(rateScale/2))
+	amount.Quo(amount, big.NewInt(rateScale))
+
+	if !amount.IsInt64() {
+		return Money{}, errors.Wrapf(ErrValidation, "converted amount of %s is too large", m)
+	}
+
+	return Money{Amount: amount.Int64(), Currency: r.To}, nil
+}
+
+func (r ExchangeRate) MarshalJSON() ([]byte, error) {
+	type exchangeRate ExchangeRate
+	return json.Marshal(struct {
+		exchangeRate
+		EffectiveDate string `json:"effectiveDate"`
+	}{
+		exchangeRate:  exchangeRate(r),
+		EffectiveDate: r.EffectiveDate.Format(dateLayout),
+	})
+}
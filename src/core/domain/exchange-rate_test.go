@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestNewExchangeRateValue(t *testing.T) {
+	cases := []struct {
+		str       string
+		rate      ExchangeRateValue
+		formatted string
+	}{
+		{"92", 92_000_000, "92"},
+		{"92.5", 92_500_000, "92.5"},
+		{"0.010870", 10_870, "0.01087"},
+		{"0.000001", 1, "0.000001"},
+	}
+
+	for _, c := range cases {
+		rate, err := NewExchangeRateValue(c.str)
+		require.NoError(t, err, c.str)
+		assert.Equal(t, c.rate, rate, c.str)
+		assert.Equal(t, c.formatted, rate.String(), c.str)
+	}
+
+	for _, str := range []string{"", "0", "0.0000001", "-1", "1.", "1234567890"} {
+		_, err := NewExchangeRateValue(str)
+		assert.ErrorIs(t, err, ErrValidation, str)
+	}
+}
+
+var executor = OrganizationResponsible{OrganizationID: "org", UserID: "responsible"}
+
+func TestNewExchangeRate(t *testing.T) {
+	rate, err := NewExchangeRate("USD", "RUB", "92.5", "2026-10-01", executor)
+	require.NoError(t, err)
+
+	data, err := json.Marshal(rate)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"rate":"92.5"`)
+	assert.Contains(t, string(data), `"effectiveDate":"2026-10-01"`)
+
+	assert.Equal(t, ID("org"), rate.OrganizationID)
+	assert.NoError(t, rate.CheckAccess(executor))
+	assert.ErrorIs(t, rate.CheckAccess(OrganizationResponsible{OrganizationID: "other"}), ErrNoPermission)
+
+	_, err = NewExchangeRate("RUB", "RUB", "1", "2026-10-01", executor)
+	assert.ErrorIs(t, err, ErrValidation)
+
+	_, err = NewExchangeRate("USD", "RUB", "92.5", "01.10.2026", executor)
+	assert.ErrorIs(t, err, ErrValidation)
+}
+
+func TestDateOf(t *testing.T) {
+	moscow := time.FixedZone("MSK", 3*60*60)
+	at := time.Date(2024, 3, 2, 1, 30, 0, 0, moscow)
+
+	assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), DateOf(at))
+}
+
+func TestExchangeRateConvert(t *testing.T) {
+	rate, err := NewExchangeRate("USD", "RUB", "92.123456", "2026-10-01", executor)
+	require.NoError(t, err)
+
+	// 10.05 USD * 92.123456 = 925.8407328 RUB
+	converted, err := rate.Convert(Money{Amount: 1005, Currency: USDCurrency})
+	require.NoError(t, err)
+	assert.Equal(t, Money{Amount: 92584, Currency: RUBCurrency}, converted)
+
+	_, err = rate.Convert(Money{Amount: 1005, Currency: EURCurrency})
+	assert.ErrorIs(t, err, ErrValidation)
+}
+
+func TestTenderCheckPrice(t *testing.T) {
+	tender := Tender{Budget: &Money{Amount: 100_000_00, Currency: RUBCurrency}}
+	rate, err := NewExchangeRate("USD", "RUB", "100", "2026-10-01", executor)
+	require.NoError(t, err)
+
+	assert.NoError(t, tender.CheckPrice(Money{Amount: 100_000_00, Currency: RUBCurrency}, nil))
+	assert.ErrorIs(t, tender.CheckPrice(Money{Amount: 100_000_01, Currency: RUBCurrency}, nil), ErrValidation)
+
+	assert.NoError(t, tender.CheckPrice(Money{Amount: 1000_00, Currency: USDCurrency}, rate))
+	assert.ErrorIs(t, tender.CheckPrice(Money{Amount: 1000_01, Currency: USDCurrency}, rate), ErrValidation)
+	assert.ErrorIs(t, tender.CheckPrice(Money{Amount: 1_00, Currency: USDCurrency}, nil), ErrValidation)
+	assert.ErrorIs(t, tender.CheckPrice(Money{Amount: 1_00, Currency: EURCurrency}, rate), ErrValidation)
+}
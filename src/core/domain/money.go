@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/pkg/errors"
+	"strconv"
+	"strings"
+)
+
+// Currency Код валюты ISO 4217
+type Currency string
+
+const (
+	RUBCurrency Currency = "RUB"
+	USDCurrency Currency = "USD"
+	EURCurrency Currency = "EUR"
+)
+
+func NewCurrency(str string) (Currency, error) {
+	switch str {
+	case string(RUBCurrency), string(USDCurrency), string(EURCurrency):
+		return Currency(str), nil
+	}
+	return "", errors.Wrapf(ErrValidation, "invalid currency: %s", str)
+}
+
+// minorUnits Кол-во минимальных единиц (копеек, центов) в единице поддерживаемых валют
+const minorUnits = 100
+
+// maxAmountDigits Максимальное кол-во цифр целой части суммы
+const maxAmountDigits = 15
+
+// ParseAmount разбирает положительную десятичную сумму вида "1500" или "1500.50" в минимальные единицы валюты
+func ParseAmount(str string) (int64, error) {
+	whole, frac, hasFrac := strings.Cut(str, ".")
+
+	if whole == "" || len(whole) > maxAmountDigits || !isDigits(whole) {
+		return 0, errors.Wrapf(ErrValidation, "invalid amount: %s", str)
+	}
+	if hasFrac && (frac == "" || len(frac) > 2 || !isDigits(frac)) {
+		return 0, errors.Wrapf(ErrValidation, "amount must have at most 2 decimal places: %s", str)
+	}
+
+	units, _ := strconv.ParseInt(whole, 10, 64)
+	cents, _ := strconv.ParseInt((frac + "00")[:2], 10, 64)
+	amount := units*minorUnits + cents
+
+	if amount <= 0 {
+		return 0, errors.Wrap(ErrValidation, "amount must be positive")
+	}
+
+	return amount, nil
+}
+
+// FormatAmount форматирует сумму в минимальных единицах валюты как десятичную строку с двумя знаками после точки
+func FormatAmount(amount int64) string {
+	sign := ""
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, amount/minorUnits, amount%minorUnits)
+}
+
+func isDigits(str string) bool {
+	return strings.Trim(str, "0123456789") == ""
+}
+
+// Money Денежная сумма. Amount хранится в минимальных единицах валюты, чтобы избежать ошибок округления
+type Money struct {
+	Amount   int64
+	Currency Currency
+}
+
+func NewMoney(amount, currency string) (Money, error) {
+	a, err := ParseAmount(amount)
+	if err != nil {
+		return Money{}, err
+	}
+
+	c, err := NewCurrency(currency)
+	if err != nil {
+		return Money{}, err
+	}
+
+	return Money{Amount: a, Currency: c}, nil
+}
+
+// validate проверяет сумму, собранную без NewMoney
+func (m Money) validate() error {
+	if m.Amount <= 0 {
+		return errors.Wrap(ErrValidation, "amount must be positive")
+	}
+	_, err := NewCurrency(string(m.Currency))
+	return err
+}
+
+func (m Money) String() string {
+	return FormatAmount(m.Amount) + " " + string(m.Currency)
+}
+
+// Exceeds проверяет, что m больше limit. Суммы в разных валютах не сравниваются
+func (m Money) Exceeds(limit Money) (bool, error) {
+	if m.Currency != limit.Currency {
+		return false, errors.Wrapf(ErrValidation, "cannot compare amounts in %s and %s", m.Currency, limit.Currency)
+	}
+	return m.Amount > limit.Amount, nil
+}
+
+// moneyJSON Сумма передается строкой, чтобы клиенты не теряли точность на числах с плавающей точкой
+type moneyJSON struct {
+	Amount   string `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(moneyJSON{Amount: FormatAmount(m.Amount), Currency: string(m.Currency)})
+}
+
+func (m *Money) UnmarshalJSON(data []byte) error {
+	var raw moneyJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	money, err := NewMoney(raw.Amount, raw.Currency)
+	if err != nil {
+		return err
+	}
+
+	*m = money
+	return nil
+}
@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParseAmount(t *testing.T) {
+	cases := []struct {
+		str       string
+		amount    int64
+		formatted string
+	}{
+		{"1500", 150000, "1500.00"},
+		{"1500.5", 150050, "1500.50"},
+		{"1500.05", 150005, "1500.05"},
+		{"0.01", 1, "0.01"},
+	}
+
+	for _, c := range cases {
+		amount, err := ParseAmount(c.str)
+		require.NoError(t, err, c.str)
+		assert.Equal(t, c.amount, amount, c.str)
+		assert.Equal(t, c.formatted, FormatAmount(amount), c.str)
+	}
+
+	for _, str := range []string{"", "0", "0.00", "-1", "1.", ".5", "1.005", "1e3", "1,5", "1234567890123456"} {
+		_, err := ParseAmount(str)
+		assert.ErrorIs(t, err, ErrValidation, str)
+	}
+}
+
+func TestMoneyJSON(t *testing.T) {
+	var m Money
+	require.NoError(t, json.Unmarshal([]byte(`{"amount":"99.9","currency":"USD"}`), &m))
+	assert.Equal(t, Money{Amount: 9990, Currency: USDCurrency}, m)
+
+	data, err := json.Marshal(m)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"amount":"99.90","currency":"USD"}`, string(data))
+
+	assert.ErrorIs(t, json.Unmarshal([]byte(`{"amount":"1","currency":"GBP"}`), &m), ErrValidation)
+}
+
+func TestMoneyExceeds(t *testing.T) {
+	budget := Money{Amount: 1000, Currency: RUBCurrency}
+
+	exceeds, err := Money{Amount: 1000, Currency: RUBCurrency}.Exceeds(budget)
+	require.NoError(t, err)
+	assert.False(t, exceeds)
+
+	exceeds, err = Money{Amount: 1001, Currency: RUBCurrency}.Exceeds(budget)
+	require.NoError(t, err)
+	assert.True(t, exceeds)
+
+	_, err = Money{Amount: 1, Currency: EURCurrency}.Exceeds(budget)
+	assert.ErrorIs(t, err, ErrValidation)
+}
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"github.com/pkg/errors"
+	"time"
+)
+
+// Language Язык писем
+type Language string
+
+const (
+	LanguageRussian Language = "ru"
+	LanguageEnglish Language = "en"
+)
+
+func NewLanguage(str string) (Language, error) {
+	switch Language(str) {
+	case LanguageRussian, LanguageEnglish:
+		return Language(str), nil
+	}
+	return "", errors.Wrapf(ErrValidation, "unsupported language - '%s'", str)
+}
+
+// NotificationPreferences Настройки уведомлений сотрудника
+type NotificationPreferences struct {
+	EmployeeID ID `json:"employeeId"`
+	// EmailEnabled дублировать ли уведомления письмами
+	EmailEnabled bool      `json:"emailEnabled"`
+	Language     Language  `json:"language"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+// DefaultNotificationPreferences настройки сотрудника, который их не менял
+func DefaultNotificationPreferences(employeeID ID) NotificationPreferences {
+	return NotificationPreferences{
+		EmployeeID:   employeeID,
+		EmailEnabled: true,
+		Language:     LanguageRussian,
+		UpdatedAt:    time.Now(),
+	}
+}
+
+func (p *NotificationPreferences) Edit(emailEnabled *bool, language *string) error {
+	if language != nil {
+		l, err := NewLanguage(*language)
+		if err != nil {
+			return err
+		}
+		p.Language = l
+	}
+
+	if emailEnabled != nil {
+		p.EmailEnabled = *emailEnabled
+	}
+
+	p.UpdatedAt = time.Now()
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"github.com/pkg/errors"
+	"time"
+)
+
+// NotificationKind Повод уведомления
+type NotificationKind string
+
+const (
+	// NotificationDecisionMadeKind по предложению сотрудника принято решение
+	NotificationDecisionMadeKind NotificationKind = "DecisionMade"
+	// NotificationBidStatusChangedKind статус предложения сотрудника изменил другой сотрудник
+	NotificationBidStatusChangedKind NotificationKind = "BidStatusChanged"
+	// NotificationBidPublishedKind на тендер организации сотрудника опубликовано предложение
+	NotificationBidPublishedKind NotificationKind = "BidPublished"
+	// NotificationTenderClosedKind закрыт тендер, на который сотрудник подал предложение
+	NotificationTenderClosedKind NotificationKind = "TenderClosed"
+	// NotificationSavedSearchMatchedKind опубликован тендер, подходящий под сохраненный поиск сотрудника
+	NotificationSavedSearchMatchedKind NotificationKind = "SavedSearchMatched"
+)
+
+// Notification Уведомление сотрудника
+type Notification struct {
+	ID          ID               `json:"id"`
+	RecipientID ID               `json:"recipientId"`
+	Kind        NotificationKind `json:"kind"`
+	// EventID событие, по которому создано уведомление
+	EventID   ID         `json:"eventId"`
+	TenderID  ID         `json:"tenderId"`
+	BidID     *ID        `json:"bidId,omitempty"`
+	Message   string     `json:"message"`
+	CreatedAt time.Time  `json:"createdAt"`
+	ReadAt    *time.Time `json:"readAt,omitempty"`
+}
+
+func NewNotification(recipientID ID, kind NotificationKind, event Event, bidID *ID, message string) Notification {
+	return Notification{
+		ID:          NewID(),
+		RecipientID: recipientID,
+		Kind:        kind,
+		EventID:     event.Meta().EventID,
+		TenderID:    TenderOf(event),
+		BidID:       bidID,
+		Message:     message,
+		CreatedAt:   time.Now(),
+	}
+}
+
+// Read отмечает уведомление прочитанным. Повторное прочтение не меняет время прочтения
+func (n *Notification) Read(employeeID ID) error {
+	if n.RecipientID != employeeID {
+		return errors.Wrap(ErrNoPermission, "notification belongs to another employee")
+	}
+
+	if n.ReadAt == nil {
+		now := time.Now()
+		n.ReadAt = &now
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"encoding/json"
+	"github.com/pkg/errors"
+	"time"
+)
+
+// OutboxMessage Доменное событие, ожидающее доставки. Записывается в одной транзакции с изменением агрегата
+type OutboxMessage struct {
+	// ID совпадает с идентификатором события, по нему получатели отбрасывают повторы
+	ID ID
+	// Seq порядковый номер записи, присваивается базой данных
+	Seq int64
+	// StreamSeq порядковый номер в потоке событий, присваивается при доставке в порядке фиксации транзакций,
+	// nil до доставки
+	StreamSeq   *int64
+	EventName   EventName
+	AggregateID ID
+	Payload     json.RawMessage
+	OccurredAt  time.Time
+	// Attempts кол-во неудачных попыток доставки
+	Attempts int
+	// LockToken аренда последней выборки сообщения для доставки
+	LockToken *string
+}
+
+func NewOutboxMessage(event Event) (*OutboxMessage, error) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, errors.Wrapf(err, "marshal event %s", event.EventName())
+	}
+
+	meta := event.Meta()
+
+	return &OutboxMessage{
+		ID:          meta.EventID,
+		EventName:   event.EventName(),
+		AggregateID: event.AggregateID(),
+		Payload:     payload,
+		OccurredAt:  meta.OccurredAt,
+	}, nil
+}
+
+// Event восстанавливает доменное событие сообщения
+func (m OutboxMessage) Event() (Event, error) {
+	return DecodeEvent(m.EventName, m.Payload)
+}
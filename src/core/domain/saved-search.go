@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"github.com/pkg/errors"
+	"slices"
+	"time"
+)
+
+type SavedSearchName string
+
+func NewSavedSearchName(str string) (SavedSearchName, error) {
+	if str == "" || len([]rune(str)) > 100 {
+		return "", errors.Wrap(ErrValidation, "Saved search name must be from 1 to 100 characters")
+	}
+
+	return SavedSearchName(str), nil
+}
+
+// SavedSearch Сохраненный сотрудником фильтр тендеров. Сотрудник получает уведомление,
+// когда публикуется тендер, подходящий под все заданные условия
+type SavedSearch struct {
+	ID         ID              `json:"id"`
+	EmployeeID ID              `json:"employeeId"`
+	Name       SavedSearchName `json:"name"`
+	// ServiceTypes и OrganizationIDs подходит тендер с любым из значений, пустой список не ограничивает выбор
+	ServiceTypes    []TenderServiceType `json:"serviceTypes"`
+	OrganizationIDs []ID                `json:"organizationIds"`
+	// Query полнотекстовый поисковый запрос по названию и описанию тендера
+	Query     *string   `json:"query,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+func NewSavedSearch(employeeID ID, name string, serviceTypes, organizationIDs []string, query *string) (*SavedSearch, error) {
+	n, err := NewSavedSearchName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	search := &SavedSearch{
+		ID:         NewID(),
+		EmployeeID: employeeID,
+		Name:       n,
+		Query:      query,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	if err := search.setServiceTypes(serviceTypes); err != nil {
+		return nil, err
+	}
+	search.setOrganizationIDs(organizationIDs)
+
+	if err := search.validate(); err != nil {
+		return nil, err
+	}
+
+	return search, nil
+}
+
+// CheckAccess проверяет, что сохраненный поиск принадлежит сотруднику
+func (s SavedSearch) CheckAccess(employeeID ID) error {
+	if s.EmployeeID != employeeID {
+		return errors.Wrap(ErrNoPermission, "saved search belongs to another employee")
+	}
+	return nil
+}
+
+// Edit изменяет переданные поля. Пустой query удаляет поисковый запрос
+func (s *SavedSearch) Edit(employeeID ID, name *string, serviceTypes, organizationIDs []string, query *string) error {
+	if err := s.CheckAccess(employeeID); err != nil {
+		return err
+	}
+
+	if name != nil {
+		n, err := NewSavedSearchName(*name)
+		if err != nil {
+			return err
+		}
+		s.Name = n
+	}
+
+	if serviceTypes != nil {
+		if err := s.setServiceTypes(serviceTypes); err != nil {
+			return err
+		}
+	}
+
+	if organizationIDs != nil {
+		s.setOrganizationIDs(organizationIDs)
+	}
+
+	if query != nil {
+		s.Query = query
+		if *query == "" {
+			s.Query = nil
+		}
+	}
+
+	if err := s.validate(); err != nil {
+		return err
+	}
+
+	s.UpdatedAt = time.Now()
+
+	return nil
+}
+
+func (s *SavedSearch) setServiceTypes(serviceTypes []string) error {
+	result := make([]TenderServiceType, 0, len(serviceTypes))
+	for _, str := range serviceTypes {
+		t, err := NewTenderServiceType(str)
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(result, t) {
+			result = append(result, t)
+		}
+	}
+
+	s.ServiceTypes = result
+
+	return nil
+}
+
+func (s *SavedSearch) setOrganizationIDs(organizationIDs []string) {
+	result := make([]ID, 0, len(organizationIDs))
+	for _, str := range organizationIDs {
+		if !slices.Contains(result, ID(str)) {
+			result = append(result, ID(str))
+		}
+	}
+
+	s.OrganizationIDs = result
+}
+
+// validate не дает сохранить поиск без условий, иначе он срабатывал бы на каждый тендер
+func (s SavedSearch) validate() error {
+	if len(s.ServiceTypes) == 0 && len(s.OrganizationIDs) == 0 && s.Query == nil {
+		return errors.Wrap(ErrValidation, "Saved search must have at least one of serviceTypes, organizationIds or query")
+	}
+	return nil
+}
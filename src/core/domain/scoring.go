@@ -0,0 +1,202 @@
+package domain
+
+import (
+	"github.com/pkg/errors"
+	"math"
+	"slices"
+	"time"
+)
+
+// ScoringCriterionName Критерий оценки предложений
+type ScoringCriterionName string
+
+const (
+	PriceCriterion        ScoringCriterionName = "price"
+	DeliveryTimeCriterion ScoringCriterionName = "deliveryTime"
+	QualityCriterion      ScoringCriterionName = "quality"
+)
+
+func NewScoringCriterionName(str string) (ScoringCriterionName, error) {
+	switch str {
+	case string(PriceCriterion), string(DeliveryTimeCriterion), string(QualityCriterion):
+		return ScoringCriterionName(str), nil
+	}
+	return "", errors.Wrapf(ErrValidation, "invalid scoring criterion: %s", str)
+}
+
+// ScoringCriterion Критерий оценки и его вес в процентах
+type ScoringCriterion struct {
+	Name   ScoringCriterionName `json:"name"`
+	Weight int                  `json:"weight"`
+}
+
+// ScoringCriteria Критерии оценки предложений тендера. Сумма весов равна 100,
+// пустой список означает, что предложения не оцениваются
+type ScoringCriteria []ScoringCriterion
+
+func NewScoringCriteria(criteria []ScoringCriterion) (ScoringCriteria, error) {
+	result := make(ScoringCriteria, 0, len(criteria))
+	total := 0
+
+	for _, c := range criteria {
+		name, err := NewScoringCriterionName(string(c.Name))
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := result.Find(name); ok {
+			return nil, errors.Wrapf(ErrValidation, "duplicate scoring criterion: %s", name)
+		}
+		if c.Weight <= 0 || c.Weight > 100 {
+			return nil, errors.Wrapf(ErrValidation, "weight of %s must be from 1 to 100", name)
+		}
+
+		total += c.Weight
+		result = append(result, ScoringCriterion{Name: name, Weight: c.Weight})
+	}
+
+	if len(result) > 0 && total != 100 {
+		return nil, errors.Wrapf(ErrValidation, "scoring criteria weights must sum to 100, got %d", total)
+	}
+
+	return result, nil
+}
+
+func (c ScoringCriteria) Find(name ScoringCriterionName) (ScoringCriterion, bool) {
+	i := slices.IndexFunc(c, func(criterion ScoringCriterion) bool {
+		return criterion.Name == name
+	})
+	if i == -1 {
+		return ScoringCriterion{}, false
+	}
+	return c[i], true
+}
+
+// MaxScore Максимальная оценка по критерию
+const MaxScore = 10
+
+// Score Оценка по критерию от 0 до MaxScore
+type Score int
+
+func NewScore(n int) (Score, error) {
+	if n < 0 || n > MaxScore {
+		return 0, errors.Wrapf(ErrValidation, "score must be from 0 to %d", MaxScore)
+	}
+	return Score(n), nil
+}
+
+// BidScore Оценка предложения по критерию, выставленная ответственным за организацию тендера
+type BidScore struct {
+	BidID       ID                   `json:"bidId"`
+	EvaluatorID ID                   `json:"evaluatorId"`
+	Criterion   ScoringCriterionName `json:"criterion"`
+	Score       Score                `json:"score"`
+	UpdatedAt   time.Time            `json:"updatedAt"`
+}
+
+// NewTenderCriteriaSet возвращает событие замены критериев оценки tender
+func NewTenderCriteriaSet(tender Tender, editorID ID, criteria ScoringCriteria) TenderCriteriaSet {
+	return TenderCriteriaSet{
+		EventMeta:      newEventMeta(),
+		TenderID:       tender.ID,
+		OrganizationID: tender.OrganizationID,
+		Criteria:       criteria,
+		EditorID:       editorID,
+	}
+}
+
+// NewBidScored возвращает событие оценки предложения bid на тендер tender
+func NewBidScored(tender Tender, bid Bid, evaluatorID ID, scores []BidScore) BidScored {
+	return BidScored{
+		EventMeta:      newEventMeta(),
+		BidID:          bid.ID,
+		TenderID:       tender.ID,
+		OrganizationID: tender.OrganizationID,
+		EvaluatorID:    evaluatorID,
+		Scores:         scores,
+	}
+}
+
+// NewBidScores создает оценки предложения по критериям тендера. Ответственный может оценить
+// только часть критериев, повторная оценка по критерию заменяет предыдущую
+func NewBidScores(criteria ScoringCriteria, bid Bid, evaluatorID ID, scores map[string]int) ([]BidScore, error) {
+	if len(criteria) == 0 {
+		return nil, errors.Wrap(ErrValidation, "Tender has no scoring criteria")
+	}
+	if bid.Status != BidPublishedStatus {
+		return nil, errors.Wrap(ErrValidation, "only published bids can be scored")
+	}
+	if len(scores) == 0 {
+		return nil, errors.Wrap(ErrValidation, "scores must not be empty")
+	}
+
+	now := time.Now()
+	result := make([]BidScore, 0, len(scores))
+
+	// Оценки идут в порядке критериев тендера, чтобы ответ не зависел от порядка обхода map
+	for _, c := range criteria {
+		n, ok := scores[string(c.Name)]
+		if !ok {
+			continue
+		}
+
+		score, err := NewScore(n)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, BidScore{
+			BidID:       bid.ID,
+			EvaluatorID: evaluatorID,
+			Criterion:   c.Name,
+			Score:       score,
+			UpdatedAt:   now,
+		})
+	}
+
+	if len(result) != len(scores) {
+		return nil, errors.Wrap(ErrValidation, "scores contain criteria that Tender does not define")
+	}
+
+	return result, nil
+}
+
+// CriterionScore Средняя оценка предложения по критерию
+type CriterionScore struct {
+	Name   ScoringCriterionName `json:"name"`
+	Weight int                  `json:"weight"`
+	// Score среднее оценок ответственных, nil если предложение по критерию еще не оценивали
+	Score       *float64 `json:"score"`
+	Evaluations int      `json:"evaluations"`
+}
+
+// CriterionScores возвращает средние оценки предложения bidID по критериям тендера в их порядке.
+// Оценки по критериям, которых больше нет у тендера, не учитываются
+func CriterionScores(criteria ScoringCriteria, bidID ID, scores []BidScore) []CriterionScore {
+	result := make([]CriterionScore, 0, len(criteria))
+
+	for _, c := range criteria {
+		cs := CriterionScore{Name: c.Name, Weight: c.Weight}
+		sum := 0
+
+		for _, s := range scores {
+			if s.BidID == bidID && s.Criterion == c.Name {
+				sum += int(s.Score)
+				cs.Evaluations++
+			}
+		}
+
+		if cs.Evaluations > 0 {
+			avg := roundScore(float64(sum) / float64(cs.Evaluations))
+			cs.Score = &avg
+		}
+
+		result = append(result, cs)
+	}
+
+	return result
+}
+
+// roundScore округляет оценку до сотых
+func roundScore(score float64) float64 {
+	return math.Round(score*100) / 100
+}
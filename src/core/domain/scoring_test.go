@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestNewScoringCriteria(t *testing.T) {
+	criteria, err := NewScoringCriteria([]ScoringCriterion{
+		{Name: PriceCriterion, Weight: 50},
+		{Name: DeliveryTimeCriterion, Weight: 20},
+		{Name: QualityCriterion, Weight: 30},
+	})
+	require.NoError(t, err)
+	assert.Len(t, criteria, 3)
+
+	criteria, err = NewScoringCriteria(nil)
+	require.NoError(t, err)
+	assert.Empty(t, criteria)
+
+	invalid := [][]ScoringCriterion{
+		{{Name: PriceCriterion, Weight: 50}, {Name: QualityCriterion, Weight: 40}},
+		{{Name: PriceCriterion, Weight: 50}, {Name: PriceCriterion, Weight: 50}},
+		{{Name: PriceCriterion, Weight: 100}, {Name: QualityCriterion, Weight: 0}},
+		{{Name: "warranty", Weight: 100}},
+	}
+	for _, c := range invalid {
+		_, err := NewScoringCriteria(c)
+		assert.ErrorIs(t, err, ErrValidation, c)
+	}
+}
+
+func TestNewBidScores(t *testing.T) {
+	criteria := ScoringCriteria{{Name: PriceCriterion, Weight: 60}, {Name: QualityCriterion, Weight: 40}}
+	bid := Bid{ID: NewID(), Status: BidPublishedStatus}
+	evaluator := NewID()
+
+	scores, err := NewBidScores(criteria, bid, evaluator, map[string]int{"quality": 7, "price": 9})
+	require.NoError(t, err)
+	require.Len(t, scores, 2)
+	assert.Equal(t, PriceCriterion, scores[0].Criterion)
+	assert.Equal(t, Score(9), scores[0].Score)
+
+	_, err = NewBidScores(criteria, bid, evaluator, map[string]int{"deliveryTime": 5})
+	assert.ErrorIs(t, err, ErrValidation)
+
+	_, err = NewBidScores(criteria, bid, evaluator, map[string]int{"price": 11})
+	assert.ErrorIs(t, err, ErrValidation)
+
+	_, err = NewBidScores(nil, bid, evaluator, map[string]int{"price": 5})
+	assert.ErrorIs(t, err, ErrValidation)
+
+	bid.Status = BidCreatedStatus
+	_, err = NewBidScores(criteria, bid, evaluator, map[string]int{"price": 5})
+	assert.ErrorIs(t, err, ErrValidation)
+}
+
+func TestCriterionScores(t *testing.T) {
+	criteria := ScoringCriteria{{Name: PriceCriterion, Weight: 60}, {Name: QualityCriterion, Weight: 40}}
+	bid := NewID()
+
+	scores := []BidScore{
+		// price (8 + 9) / 2 = 8.5, удаленный критерий не учитывается
+		{BidID: bid, EvaluatorID: "a", Criterion: PriceCriterion, Score: 8},
+		{BidID: bid, EvaluatorID: "b", Criterion: PriceCriterion, Score: 9},
+		{BidID: bid, EvaluatorID: "a", Criterion: DeliveryTimeCriterion, Score: 10},
+		// оценки другого предложения
+		{BidID: NewID(), EvaluatorID: "a", Criterion: QualityCriterion, Score: 3},
+	}
+
+	result := CriterionScores(criteria, bid, scores)
+	require.Len(t, result, 2)
+
+	price := result[0]
+	assert.Equal(t, PriceCriterion, price.Name)
+	assert.Equal(t, 60, price.Weight)
+	require.NotNil(t, price.Score)
+	assert.Equal(t, 8.5, *price.Score)
+	assert.Equal(t, 2, price.Evaluations)
+
+	quality := result[1]
+	assert.Nil(t, quality.Score)
+	assert.Equal(t, 0, quality.Evaluations)
+}
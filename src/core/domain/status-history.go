@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"github.com/pkg/errors"
+	"strings"
+	"time"
+)
+
+// StatusChange Запись журнала изменений статуса
+type StatusChange[S ~string] struct {
+	ID ID `json:"-"`
+	// From пустой для записи о создании объекта
+	From      S         `json:"from,omitempty"`
+	To        S         `json:"to"`
+	EditorID  ID        `json:"editorId"`
+	Reason    *string   `json:"reason,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type TenderStatusChange = StatusChange[TenderStatus]
+
+type BidStatusChange = StatusChange[BidStatus]
+
+func newStatusChange[S ~string](from, to S, editor ID, reason *string) StatusChange[S] {
+	return StatusChange[S]{
+		ID:        NewID(),
+		From:      from,
+		To:        to,
+		EditorID:  editor,
+		Reason:    reason,
+		CreatedAt: time.Now(),
+	}
+}
+
+// NewStatusChangeReason проверяет причину изменения статуса, пустая причина означает ее отсутствие
+func NewStatusChangeReason(reason *string) (*string, error) {
+	if reason == nil {
+		return nil, nil
+	}
+
+	r := strings.TrimSpace(*reason)
+	if r == "" {
+		return nil, nil
+	}
+
+	if len(r) > 500 {
+		return nil, errors.Wrap(ErrValidation, "status change reason cannot exceed 500 characters")
+	}
+
+	return &r, nil
+}
@@ -14,6 +14,14 @@ const (
 	TenderClosedStatus    TenderStatus = "CLOSED"
 )
 
+func NewTenderStatus(str string) (TenderStatus, error) {
+	switch str {
+	case string(TenderCreatedStatus), string(TenderPublishedStatus), string(TenderClosedStatus):
+		return TenderStatus(str), nil
+	}
+	return "", errors.Wrap(ErrValidation, "Tender status is invalid")
+}
+
 type TenderServiceType string
 
 const (
@@ -64,22 +72,27 @@ func NewTenderDescription(str string) (TenderDescription, error) {
 }
 
 type TenderSnapshot struct {
-	ID          ID
-	Name        TenderName
-	Description TenderDescription
-	ServiceType TenderServiceType
-	Version     TenderVersion
-	CreatedAt   time.Time
+	ID          ID                `json:"-"`
+	Name        TenderName        `json:"name"`
+	Description TenderDescription `json:"description"`
+	ServiceType TenderServiceType `json:"serviceType"`
+	Budget      *Money            `json:"budget,omitempty"`
+	Version     TenderVersion     `json:"version"`
+	// CreatedAt и EditorID время создания версии и сотрудник, который ее создал
+	CreatedAt time.Time `json:"createdAt"`
+	EditorID  ID        `json:"editorId"`
 }
 
-func NewTenderSnapshot(name TenderName, description TenderDescription, serviceType TenderServiceType, v TenderVersion) TenderSnapshot {
+func NewTenderSnapshot(name TenderName, description TenderDescription, serviceType TenderServiceType, budget *Money, v TenderVersion, editorID ID, createdAt time.Time) TenderSnapshot {
 	return TenderSnapshot{
 		ID:          NewID(),
 		Name:        name,
 		Description: description,
 		ServiceType: serviceType,
+		Budget:      budget,
 		Version:     v,
-		CreatedAt:   time.Now(),
+		CreatedAt:   createdAt,
+		EditorID:    editorID,
 	}
 }
 
@@ -92,7 +105,104 @@ type Tender struct {
 	ServiceType    TenderServiceType `json:"serviceType"`
 	Version        TenderVersion     `json:"version"`
 	Snapshots      []TenderSnapshot  `json:"-"`
-	CreatedAt      time.Time         `json:"createdAt"`
+	// Budget бюджет тендера, цены предложений не могут его превышать
+	Budget *Money `json:"budget,omitempty"`
+	// SubmissionDeadline срок приема предложений, после него тендер закрывается автоматически
+	SubmissionDeadline *time.Time `json:"submissionDeadline,omitempty"`
+	// EvaluationDeadline срок, до которого организация обещает принять решение по предложениям
+	EvaluationDeadline *time.Time `json:"evaluationDeadline,omitempty"`
+	// PublishAt время, в которое созданный тендер будет опубликован автоматически
+	PublishAt *time.Time `json:"publishAt,omitempty"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt time.Time  `json:"updatedAt"`
+	// EditorID и EditedAt сотрудник, создавший текущую версию, и время ее создания
+	EditorID ID        `json:"-"`
+	EditedAt time.Time `json:"-"`
+	// StatusChanges изменения статуса, еще не записанные в журнал
+	StatusChanges []TenderStatusChange `json:"-"`
+	events
+}
+
+// touch отмечает момент последнего изменения Tender
+func (t *Tender) touch() {
+	t.UpdatedAt = time.Now()
+}
+
+// takeSnapshot сохраняет текущую версию Tender в Snapshots
+func (t *Tender) takeSnapshot() {
+	t.Snapshots = append(t.Snapshots, NewTenderSnapshot(t.Name, t.Description, t.ServiceType, t.Budget, t.Version, t.EditorID, t.EditedAt))
+}
+
+// nextVersion отмечает текущее состояние Tender новой версией, созданной editor
+func (t *Tender) nextVersion(editor ID) {
+	t.Version++
+	t.EditorID = editor
+	t.EditedAt = time.Now()
+	t.UpdatedAt = t.EditedAt
+}
+
+// CurrentVersion возвращает текущее состояние Tender в виде снимка
+func (t Tender) CurrentVersion() TenderSnapshot {
+	return TenderSnapshot{
+		ID:          t.ID,
+		Name:        t.Name,
+		Description: t.Description,
+		ServiceType: t.ServiceType,
+		Budget:      t.Budget,
+		Version:     t.Version,
+		CreatedAt:   t.EditedAt,
+		EditorID:    t.EditorID,
+	}
+}
+
+// Versions возвращает все версии Tender по возрастанию, включая текущую
+func (t Tender) Versions() []TenderSnapshot {
+	versions := make([]TenderSnapshot, 0, len(t.Snapshots)+1)
+	versions = append(versions, t.Snapshots...)
+	versions = append(versions, t.CurrentVersion())
+
+	slices.SortFunc(versions, func(a, b TenderSnapshot) int {
+		return int(a.Version) - int(b.Version)
+	})
+
+	return versions
+}
+
+// FindVersion возвращает версию Tender с номером version
+func (t Tender) FindVersion(version int) (*TenderSnapshot, error) {
+	v, err := NewTenderVersion(version)
+
+	if err != nil {
+		return nil, err
+	}
+
+	versions := t.Versions()
+
+	i := slices.IndexFunc(versions, func(s TenderSnapshot) bool {
+		return s.Version == v
+	})
+
+	if i == -1 {
+		return nil, errors.Wrapf(ErrNotFound, "Tender version %d not found", v)
+	}
+
+	return &versions[i], nil
+}
+
+// DiffVersions сравнивает версии from и to
+func (t Tender) DiffVersions(from, to int) (*VersionDiff, error) {
+	a, err := t.FindVersion(from)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := t.FindVersion(to)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := a.Diff(*b)
+	return &diff, nil
 }
 
 func (t *Tender) Rollback(executor OrganizationResponsible, version int) error {
@@ -117,22 +227,33 @@ func (t *Tender) Rollback(executor OrganizationResponsible, version int) error {
 
 	snapshot := t.Snapshots[i]
 
-	t.Snapshots = append(t.Snapshots, NewTenderSnapshot(t.Name, t.Description, t.ServiceType, t.Version))
+	t.takeSnapshot()
 	t.Name = snapshot.Name
 	t.Description = snapshot.Description
 	t.ServiceType = snapshot.ServiceType
-	t.Version++
+	t.Budget = snapshot.Budget
+	t.nextVersion(executor.UserID)
+
+	t.record(TenderRolledBack{
+		EventMeta:       newEventMeta(),
+		TenderID:        t.ID,
+		OrganizationID:  t.OrganizationID,
+		Version:         t.Version,
+		RestoredVersion: snapshot.Version,
+		EditorID:        executor.UserID,
+	})
 
 	return nil
 }
 
-func (t *Tender) Edit(executor OrganizationResponsible, name, description, serviceType *string) error {
+// Edit изменяет переданные поля. Сроки не входят в версии тендера и не восстанавливаются при откате
+func (t *Tender) Edit(executor OrganizationResponsible, name, description, serviceType *string, budget *Money, submissionDeadline, evaluationDeadline *time.Time) error {
 
 	if executor.OrganizationID != t.OrganizationID {
 		return errors.Wrap(ErrNoPermission, "Organization responsible has no access to edit Tender")
 	}
 
-	t.Snapshots = append(t.Snapshots, NewTenderSnapshot(t.Name, t.Description, t.ServiceType, t.Version))
+	t.takeSnapshot()
 
 	if name != nil {
 		n, err := NewTenderName(*name)
@@ -161,35 +282,205 @@ func (t *Tender) Edit(executor OrganizationResponsible, name, description, servi
 		t.ServiceType = sType
 	}
 
-	t.Version++
+	if budget != nil {
+		if err := budget.validate(); err != nil {
+			return err
+		}
+		t.Budget = budget
+	}
+
+	if err := t.setDeadlines(submissionDeadline, evaluationDeadline, time.Now()); err != nil {
+		return err
+	}
+
+	t.nextVersion(executor.UserID)
+
+	t.record(TenderEdited{
+		EventMeta:      newEventMeta(),
+		TenderID:       t.ID,
+		OrganizationID: t.OrganizationID,
+		Version:        t.Version,
+		EditorID:       executor.UserID,
+	})
 
 	return nil
 }
 
-func (t *Tender) ChangeStatus(executor OrganizationResponsible, status string) error {
+func (t *Tender) ChangeStatus(executor OrganizationResponsible, status string, reason *string) error {
 
 	if executor.OrganizationID != t.OrganizationID {
 		return errors.Wrap(ErrNoPermission, "Organization responsible has no access to change status of Tender")
 	}
 
-	switch status {
-	case string(TenderClosedStatus):
-		t.Status = TenderClosedStatus
-		return nil
+	s, err := NewTenderStatus(status)
+
+	if err != nil {
+		return err
+	}
+
+	r, err := NewStatusChangeReason(reason)
+
+	if err != nil {
+		return err
+	}
 
-	case string(TenderPublishedStatus):
-		t.Status = TenderPublishedStatus
+	if s == t.Status {
 		return nil
+	}
+
+	// Иначе тендер был бы снова закрыт планировщиком
+	if s == TenderPublishedStatus && t.deadlinePassed(time.Now()) {
+		return errors.Wrap(ErrValidation, "Tender submission deadline has passed")
+	}
+
+	t.changeStatus(s, executor.UserID, r)
+
+	return nil
+}
+
+// CloseExpired закрывает тендер от имени SystemEditorID, если срок приема предложений истек.
+// Возвращает false, если тендер закрывать не нужно
+func (t *Tender) CloseExpired(now time.Time) bool {
+	if t.Status == TenderClosedStatus || !t.deadlinePassed(now) {
+		return false
+	}
+
+	reason := "Submission deadline has passed"
+	t.changeStatus(TenderClosedStatus, SystemEditorID, &reason)
+
+	return true
+}
+
+// Schedule назначает автоматическую публикацию тендера на publishAt, nil отменяет ее.
+// Запланировать можно только еще не опубликованный тендер
+func (t *Tender) Schedule(executor OrganizationResponsible, publishAt *time.Time) error {
+	if executor.OrganizationID != t.OrganizationID {
+		return errors.Wrap(ErrNoPermission, "Organization responsible has no access to schedule Tender")
+	}
+
+	if t.Status != TenderCreatedStatus {
+		return errors.Wrapf(ErrValidation, "Tender in status %s cannot be scheduled", t.Status)
+	}
+
+	if publishAt != nil {
+		if !publishAt.After(time.Now()) {
+			return errors.Wrap(ErrValidation, "Tender publication time must be in the future")
+		}
+		if t.SubmissionDeadline != nil && !publishAt.Before(*t.SubmissionDeadline) {
+			return errors.Wrap(ErrValidation, "Tender publication time must be before submission deadline")
+		}
+	}
+
+	t.PublishAt = publishAt
+	t.touch()
+
+	return nil
+}
+
+// PublishScheduled публикует тендер от имени SystemEditorID, если наступило время запланированной публикации.
+// Возвращает false, если публиковать тендер не нужно
+func (t *Tender) PublishScheduled(now time.Time) bool {
+	if t.Status != TenderCreatedStatus || t.PublishAt == nil || now.Before(*t.PublishAt) {
+		return false
+	}
 
-	case string(TenderCreatedStatus):
-		t.Status = TenderCreatedStatus
+	reason := "Scheduled publication"
+	t.changeStatus(TenderPublishedStatus, SystemEditorID, &reason)
+
+	return true
+}
+
+// CheckPrice проверяет, что цена предложения не превышает бюджет тендера, если он задан.
+// Цена в другой валюте сначала переводится в валюту бюджета по курсу rate
+func (t Tender) CheckPrice(price Money, rate *ExchangeRate) error {
+	if t.Budget == nil {
 		return nil
 	}
 
-	return errors.Wrap(ErrValidation, "Tender status is invalid")
+	if price.Currency != t.Budget.Currency {
+		if rate == nil || rate.To != t.Budget.Currency {
+			return errors.Wrapf(ErrValidation, "no exchange rate from %s to %s", price.Currency, t.Budget.Currency)
+		}
+
+		converted, err := rate.Convert(price)
+		if err != nil {
+			return err
+		}
+		price = converted
+	}
+
+	exceeds, err := price.Exceeds(*t.Budget)
+	if err != nil {
+		return err
+	}
+	if exceeds {
+		return errors.Wrapf(ErrValidation, "Bid price cannot exceed Tender budget of %s", t.Budget)
+	}
+
+	return nil
+}
+
+// AcceptsBids проверяет, что срок приема предложений не истек
+func (t Tender) AcceptsBids(now time.Time) error {
+	if t.deadlinePassed(now) {
+		return errors.Wrap(ErrValidation, "Tender submission deadline has passed")
+	}
+	return nil
+}
+
+func (t Tender) deadlinePassed(now time.Time) bool {
+	return t.SubmissionDeadline != nil && !now.Before(*t.SubmissionDeadline)
+}
+
+// setDeadlines изменяет переданные сроки. Новый срок не может быть в прошлом,
+// а решение не может быть назначено раньше окончания приема предложений
+func (t *Tender) setDeadlines(submission, evaluation *time.Time, now time.Time) error {
+	if submission == nil {
+		submission = t.SubmissionDeadline
+	} else if !submission.After(now) {
+		return errors.Wrap(ErrValidation, "Tender submission deadline must be in the future")
+	}
+
+	if evaluation == nil {
+		evaluation = t.EvaluationDeadline
+	} else if !evaluation.After(now) {
+		return errors.Wrap(ErrValidation, "Tender evaluation deadline must be in the future")
+	}
+
+	if evaluation != nil && submission != nil && evaluation.Before(*submission) {
+		return errors.Wrap(ErrValidation, "Tender evaluation deadline cannot be before submission deadline")
+	}
+
+	if t.PublishAt != nil && submission != nil && !t.PublishAt.Before(*submission) {
+		return errors.Wrap(ErrValidation, "Tender submission deadline must be after publication time")
+	}
+
+	t.SubmissionDeadline = submission
+	t.EvaluationDeadline = evaluation
+
+	return nil
+}
+
+func (t *Tender) changeStatus(s TenderStatus, editor ID, reason *string) {
+	t.StatusChanges = append(t.StatusChanges, newStatusChange(t.Status, s, editor, reason))
+	t.record(TenderStatusChanged{
+		EventMeta:      newEventMeta(),
+		TenderID:       t.ID,
+		OrganizationID: t.OrganizationID,
+		From:           t.Status,
+		To:             s,
+		EditorID:       editor,
+		Reason:         reason,
+	})
+	t.Status = s
+	// Запланированная публикация теряет смысл, как только тендер покинул статус CREATED
+	if s != TenderCreatedStatus {
+		t.PublishAt = nil
+	}
+	t.touch()
 }
 
-func NewTender(name, description, serviceType, organizationID string, executor OrganizationResponsible) (*Tender, error) {
+func NewTender(name, description, serviceType, organizationID string, budget *Money, submissionDeadline, evaluationDeadline *time.Time, executor OrganizationResponsible) (*Tender, error) {
 
 	orgID := ID(organizationID)
 
@@ -215,17 +506,44 @@ func NewTender(name, description, serviceType, organizationID string, executor O
 		return nil, err
 	}
 
+	if budget != nil {
+		if err := budget.validate(); err != nil {
+			return nil, err
+		}
+	}
+
 	id := NewID()
+	createdAt := time.Now()
 
-	return &Tender{
+	tender := &Tender{
 		ID:             id,
 		Name:           n,
 		Description:    desc,
 		Status:         TenderCreatedStatus,
 		ServiceType:    t,
+		Budget:         budget,
 		OrganizationID: orgID,
 		Version:        TenderVersion(1),
-		CreatedAt:      time.Now(),
+		CreatedAt:      createdAt,
+		UpdatedAt:      createdAt,
+		EditorID:       executor.UserID,
+		EditedAt:       createdAt,
 		Snapshots:      []TenderSnapshot{},
-	}, nil
+		StatusChanges:  []TenderStatusChange{newStatusChange("", TenderCreatedStatus, executor.UserID, nil)},
+	}
+
+	if err := tender.setDeadlines(submissionDeadline, evaluationDeadline, createdAt); err != nil {
+		return nil, err
+	}
+
+	tender.record(TenderCreated{
+		EventMeta:      newEventMeta(),
+		TenderID:       id,
+		OrganizationID: orgID,
+		Name:           n,
+		ServiceType:    t,
+		CreatorID:      executor.UserID,
+	})
+
+	return tender, nil
 }
@@ -0,0 +1,235 @@
+package domain
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestTenderDeadlines(t *testing.T) {
+	executor := OrganizationResponsible{OrganizationID: "org", UserID: "user"}
+	submission := time.Now().Add(time.Hour)
+	evaluation := submission.Add(-time.Minute)
+
+	_, err := NewTender("Доставка", "Описание", string(TenderDeliveryServiceType), "org", nil, &submission, &evaluation, executor)
+	assert.ErrorIs(t, err, ErrValidation)
+
+	past := time.Now().Add(-time.Hour)
+	_, err = NewTender("Доставка", "Описание", string(TenderDeliveryServiceType), "org", nil, &past, nil, executor)
+	assert.ErrorIs(t, err, ErrValidation)
+
+	tender, err := NewTender("Доставка", "Описание", string(TenderDeliveryServiceType), "org", nil, &submission, nil, executor)
+	require.NoError(t, err)
+	require.NoError(t, tender.ChangeStatus(executor, string(TenderPublishedStatus), nil))
+	tender.PullEvents()
+
+	assert.NoError(t, tender.AcceptsBids(time.Now()))
+	assert.False(t, tender.CloseExpired(time.Now()))
+
+	after := submission.Add(time.Second)
+	assert.ErrorIs(t, tender.AcceptsBids(after), ErrValidation)
+	require.True(t, tender.CloseExpired(after))
+	assert.Equal(t, TenderClosedStatus, tender.Status)
+	// Закрытый тендер повторно не закрывается
+	assert.False(t, tender.CloseExpired(after))
+
+	events := tender.PullEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, TenderClosedEvent, events[0].EventName())
+	assert.Equal(t, SystemEditorID, events[0].(TenderStatusChanged).EditorID)
+}
+
+func TestTenderSchedule(t *testing.T) {
+	executor := OrganizationResponsible{OrganizationID: "org", UserID: "user"}
+	deadline := time.Now().Add(2 * time.Hour)
+
+	tender, err := NewTender("Доставка", "Описание", string(TenderDeliveryServiceType), "org", nil, &deadline, nil, executor)
+	require.NoError(t, err)
+	tender.PullEvents()
+
+	late := deadline.Add(time.Minute)
+	assert.ErrorIs(t, tender.Schedule(executor, &late), ErrValidation)
+
+	publishAt := time.Now().Add(time.Hour)
+	require.NoError(t, tender.Schedule(executor, &publishAt))
+	// Срок приема предложений не может наступить раньше публикации
+	early := publishAt.Add(-time.Minute)
+	assert.ErrorIs(t, tender.Edit(executor, nil, nil, nil, nil, &early, nil), ErrValidation)
+
+	assert.False(t, tender.PublishScheduled(time.Now()))
+	require.True(t, tender.PublishScheduled(publishAt))
+	assert.Equal(t, TenderPublishedStatus, tender.Status)
+	assert.Nil(t, tender.PublishAt)
+	assert.ErrorIs(t, tender.Schedule(executor, &publishAt), ErrValidation)
+
+	events := tender.PullEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, TenderPublishedEvent, events[0].EventName())
+}
+
+func TestTenderVersions(t *testing.T) {
+	executor := OrganizationResponsible{OrganizationID: "org", UserID: "user"}
+
+	tender, err := NewTender("Доставка", "Описание", string(TenderDeliveryServiceType), "org", nil, nil, nil, executor)
+	require.NoError(t, err)
+
+	for _, name := range []string{"Доставка 2", "Доставка 3"} {
+		require.NoError(t, tender.Edit(executor, &name, nil, nil, nil, nil, nil))
+	}
+
+	versions := tender.Versions()
+	require.Len(t, versions, 3)
+	for i, v := range versions {
+		assert.Equal(t, TenderVersion(i+1), v.Version)
+	}
+
+	v, err := tender.FindVersion(2)
+	require.NoError(t, err)
+	assert.Equal(t, TenderName("Доставка 2"), v.Name)
+
+	v, err = tender.FindVersion(3)
+	require.NoError(t, err)
+	assert.Equal(t, tender.CurrentVersion(), *v)
+
+	_, err = tender.FindVersion(4)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	_, err = tender.FindVersion(0)
+	assert.ErrorIs(t, err, ErrValidation)
+}
+
+func TestBidVersions(t *testing.T) {
+	price := Money{Amount: 1000, Currency: RUBCurrency}
+
+	bid, err := NewBid("Предложение", "Описание", string(BidAuthorUserType), price, "tender", "user")
+	require.NoError(t, err)
+
+	name := "Предложение 2"
+	require.NoError(t, bid.Edit("user", &name, nil, nil))
+
+	versions := bid.Versions()
+	require.Len(t, versions, 2)
+	assert.Equal(t, BidName("Предложение"), versions[0].Name)
+	assert.Equal(t, BidName("Предложение 2"), versions[1].Name)
+
+	v, err := bid.FindVersion(1)
+	require.NoError(t, err)
+	assert.Equal(t, BidVersion(1), v.Version)
+
+	_, err = bid.FindVersion(3)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestTenderVersionEditors(t *testing.T) {
+	creator := OrganizationResponsible{OrganizationID: "org", UserID: "creator"}
+	editor := OrganizationResponsible{OrganizationID: "org", UserID: "editor"}
+	other := OrganizationResponsible{OrganizationID: "other", UserID: "stranger"}
+
+	tender, err := NewTender("Доставка", "Описание", string(TenderDeliveryServiceType), "org", nil, nil, nil, creator)
+	require.NoError(t, err)
+	createdAt := tender.EditedAt
+
+	name := "Доставка 2"
+	assert.ErrorIs(t, tender.Edit(other, &name, nil, nil, nil, nil, nil), ErrNoPermission)
+
+	before := time.Now()
+	require.NoError(t, tender.Edit(editor, &name, nil, nil, nil, nil, nil))
+	require.NoError(t, tender.Rollback(creator, 1))
+
+	versions := tender.Versions()
+	require.Len(t, versions, 3)
+
+	assert.Equal(t, ID("creator"), versions[0].EditorID)
+	assert.Equal(t, createdAt, versions[0].CreatedAt)
+
+	assert.Equal(t, ID("editor"), versions[1].EditorID)
+	assert.False(t, versions[1].CreatedAt.Before(before))
+
+	// Откат создает новую версию от имени того, кто его выполнил
+	assert.Equal(t, ID("creator"), versions[2].EditorID)
+	assert.Equal(t, TenderName("Доставка"), versions[2].Name)
+	assert.False(t, versions[2].CreatedAt.Before(versions[1].CreatedAt))
+	assert.Equal(t, tender.EditedAt, tender.UpdatedAt)
+}
+
+func TestBidVersionEditors(t *testing.T) {
+	price := Money{Amount: 1000, Currency: RUBCurrency}
+
+	bid, err := NewBid("Предложение", "Описание", string(BidAuthorUserType), price, "tender", "author")
+	require.NoError(t, err)
+	createdAt := bid.EditedAt
+
+	name := "Предложение 2"
+	require.NoError(t, bid.Edit("editor", &name, nil, nil))
+	require.NoError(t, bid.Rollback("author", 1))
+
+	versions := bid.Versions()
+	require.Len(t, versions, 3)
+
+	assert.Equal(t, ID("author"), versions[0].EditorID)
+	assert.Equal(t, createdAt, versions[0].CreatedAt)
+	assert.Equal(t, ID("editor"), versions[1].EditorID)
+	assert.Equal(t, ID("author"), versions[2].EditorID)
+	assert.Equal(t, BidName("Предложение"), versions[2].Name)
+	assert.False(t, versions[2].CreatedAt.Before(versions[1].CreatedAt))
+	assert.Equal(t, bid.EditedAt, bid.UpdatedAt)
+}
+
+func TestTenderStatusHistory(t *testing.T) {
+	executor := OrganizationResponsible{OrganizationID: "org", UserID: "user"}
+
+	tender, err := NewTender("Доставка", "Описание", string(TenderDeliveryServiceType), "org", nil, nil, nil, executor)
+	require.NoError(t, err)
+	require.Len(t, tender.StatusChanges, 1)
+	assert.Equal(t, TenderStatus(""), tender.StatusChanges[0].From)
+	assert.Equal(t, TenderCreatedStatus, tender.StatusChanges[0].To)
+
+	reason := "  Готов к приему предложений "
+	require.NoError(t, tender.ChangeStatus(executor, string(TenderPublishedStatus), &reason))
+	// Повторная установка того же статуса не попадает в журнал
+	require.NoError(t, tender.ChangeStatus(executor, string(TenderPublishedStatus), nil))
+
+	require.Len(t, tender.StatusChanges, 2)
+	change := tender.StatusChanges[1]
+	assert.Equal(t, TenderCreatedStatus, change.From)
+	assert.Equal(t, TenderPublishedStatus, change.To)
+	assert.Equal(t, ID("user"), change.EditorID)
+	require.NotNil(t, change.Reason)
+	assert.Equal(t, "Готов к приему предложений", *change.Reason)
+}
+
+func TestMakeFinalDecisionStatusHistory(t *testing.T) {
+	owner := OrganizationResponsible{OrganizationID: "org", UserID: "owner"}
+	price := Money{Amount: 1000, Currency: RUBCurrency}
+
+	tender, err := NewTender("Доставка", "Описание", string(TenderDeliveryServiceType), "org", nil, nil, nil, owner)
+	require.NoError(t, err)
+	require.NoError(t, tender.ChangeStatus(owner, string(TenderPublishedStatus), nil))
+
+	bid, err := NewBid("Предложение", "Описание", string(BidAuthorUserType), price, tender.ID, "author")
+	require.NoError(t, err)
+	require.NoError(t, bid.ChangeStatus("author", string(BidPublishedStatus), nil))
+
+	reason := "Цена выше рынка"
+	rejected := Decision{AuthorID: "reviewer", BidID: bid.ID, TenderID: tender.ID, Status: DecisionRejectedStatus}
+	MakeFinalDecision(3, 0, rejected, owner, tender, bid, &reason)
+
+	require.Len(t, bid.StatusChanges, 3)
+	change := bid.StatusChanges[2]
+	assert.Equal(t, BidPublishedStatus, change.From)
+	assert.Equal(t, BidCanceledStatus, change.To)
+	assert.Equal(t, ID("reviewer"), change.EditorID)
+	assert.Equal(t, &reason, change.Reason)
+	assert.Len(t, tender.StatusChanges, 2)
+
+	approved := Decision{AuthorID: "reviewer", BidID: bid.ID, TenderID: tender.ID, Status: DecisionApprovedStatus}
+	MakeFinalDecision(3, 1, approved, owner, tender, bid, nil)
+	assert.Len(t, tender.StatusChanges, 2)
+
+	// Последнее одобрение из кворума закрывает тендер от имени ответственного организации
+	MakeFinalDecision(3, 2, approved, owner, tender, bid, nil)
+	require.Len(t, tender.StatusChanges, 3)
+	assert.Equal(t, TenderClosedStatus, tender.StatusChanges[2].To)
+	assert.Equal(t, ID("owner"), tender.StatusChanges[2].EditorID)
+}
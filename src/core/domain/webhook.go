@@ -0,0 +1,249 @@
+package domain
+
+import (
+	"encoding/json"
+	"github.com/pkg/errors"
+	"net/url"
+	"slices"
+	"time"
+)
+
+type WebhookURL string
+
+func NewWebhookURL(str string) (WebhookURL, error) {
+	if len(str) > 2048 {
+		return "", errors.Wrap(ErrValidation, "Webhook url cannot exceed 2048 characters")
+	}
+
+	u, err := url.Parse(str)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", errors.Wrapf(ErrValidation, "Webhook url must be an absolute http(s) url - '%s'", str)
+	}
+
+	return WebhookURL(str), nil
+}
+
+// WebhookSecret Ключ, которым подписываются запросы вебхука. Не возвращается в ответах API
+type WebhookSecret string
+
+func NewWebhookSecret(str string) (WebhookSecret, error) {
+	if len(str) < 16 || len(str) > 256 {
+		return "", errors.Wrap(ErrValidation, "Webhook secret must be from 16 to 256 characters")
+	}
+
+	return WebhookSecret(str), nil
+}
+
+func NewWebhookEvents(names []string) ([]EventName, error) {
+	if len(names) == 0 {
+		return nil, errors.Wrap(ErrValidation, "Webhook must be subscribed to at least one event")
+	}
+
+	events := make([]EventName, 0, len(names))
+	for _, n := range names {
+		name, err := NewEventName(n)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(events, name) {
+			events = append(events, name)
+		}
+	}
+
+	return events, nil
+}
+
+// Webhook Подписка организации на доменные события
+type Webhook struct {
+	ID             ID            `json:"id"`
+	OrganizationID ID            `json:"organizationId"`
+	URL            WebhookURL    `json:"url"`
+	Events         []EventName   `json:"events"`
+	Secret         WebhookSecret `json:"-"`
+	CreatedAt      time.Time     `json:"createdAt"`
+	UpdatedAt      time.Time     `json:"updatedAt"`
+}
+
+func NewWebhook(rawURL string, events []string, secret string, executor OrganizationResponsible) (*Webhook, error) {
+	u, err := NewWebhookURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := NewWebhookEvents(events)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := NewWebhookSecret(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	return &Webhook{
+		ID:             NewID(),
+		OrganizationID: executor.OrganizationID,
+		URL:            u,
+		Events:         e,
+		Secret:         s,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}, nil
+}
+
+// CheckAccess проверяет, что executor отвечает за организацию вебхука
+func (w Webhook) CheckAccess(executor OrganizationResponsible) error {
+	if executor.OrganizationID != w.OrganizationID {
+		return errors.Wrap(ErrNoPermission, "Organization responsible has no access to Webhook")
+	}
+	return nil
+}
+
+func (w *Webhook) Edit(executor OrganizationResponsible, rawURL *string, events []string, secret *string) error {
+	if err := w.CheckAccess(executor); err != nil {
+		return err
+	}
+
+	if rawURL != nil {
+		u, err := NewWebhookURL(*rawURL)
+		if err != nil {
+			return err
+		}
+		w.URL = u
+	}
+
+	if events != nil {
+		e, err := NewWebhookEvents(events)
+		if err != nil {
+			return err
+		}
+		w.Events = e
+	}
+
+	if secret != nil {
+		s, err := NewWebhookSecret(*secret)
+		if err != nil {
+			return err
+		}
+		w.Secret = s
+	}
+
+	w.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// Subscribed сообщает, подписан ли вебхук на событие name
+func (w Webhook) Subscribed(name EventName) bool {
+	return slices.Contains(w.Events, name)
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPendingStatus   WebhookDeliveryStatus = "Pending"
+	WebhookDeliveryDeliveredStatus WebhookDeliveryStatus = "Delivered"
+	// WebhookDeliveryDeadStatus попытки доставки исчерпаны, доставка попадает в dead-letter список
+	WebhookDeliveryDeadStatus WebhookDeliveryStatus = "Dead"
+)
+
+func NewWebhookDeliveryStatus(str string) (WebhookDeliveryStatus, error) {
+	switch str {
+	case string(WebhookDeliveryPendingStatus), string(WebhookDeliveryDeliveredStatus), string(WebhookDeliveryDeadStatus):
+		return WebhookDeliveryStatus(str), nil
+	}
+	return "", errors.Wrapf(ErrValidation, "invalid webhook delivery status - '%s'", str)
+}
+
+// WebhookPayload Тело запроса вебхука
+type WebhookPayload struct {
+	// ID идентификатор события, по нему получатель отбрасывает повторы
+	ID         ID        `json:"id"`
+	Event      EventName `json:"event"`
+	OccurredAt time.Time `json:"occurredAt"`
+	Data       Event     `json:"data"`
+}
+
+// WebhookDelivery Доставка события по вебхуку
+type WebhookDelivery struct {
+	ID        ID        `json:"id"`
+	WebhookID ID        `json:"webhookId"`
+	EventID   ID        `json:"eventId"`
+	EventName EventName `json:"event"`
+	// Payload тело запроса, формируется один раз, чтобы повторные попытки были подписаны одинаково
+	Payload        json.RawMessage       `json:"payload"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	Attempts       int                   `json:"attempts"`
+	NextAttemptAt  time.Time             `json:"nextAttemptAt"`
+	LastError      *string               `json:"lastError,omitempty"`
+	ResponseStatus *int                  `json:"responseStatus,omitempty"`
+	CreatedAt      time.Time             `json:"createdAt"`
+	DeliveredAt    *time.Time            `json:"deliveredAt,omitempty"`
+	// LockToken аренда последней выборки доставки отправителем
+	LockToken *string `json:"-"`
+}
+
+func NewWebhookDelivery(webhook Webhook, event Event) (*WebhookDelivery, error) {
+	meta := event.Meta()
+
+	payload, err := json.Marshal(WebhookPayload{
+		ID:         meta.EventID,
+		Event:      event.EventName(),
+		OccurredAt: meta.OccurredAt,
+		Data:       event,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "marshal webhook payload %s", event.EventName())
+	}
+
+	now := time.Now()
+
+	return &WebhookDelivery{
+		ID:            NewID(),
+		WebhookID:     webhook.ID,
+		EventID:       meta.EventID,
+		EventName:     event.EventName(),
+		Payload:       payload,
+		Status:        WebhookDeliveryPendingStatus,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}, nil
+}
+
+// Delivered отмечает успешную доставку
+func (d *WebhookDelivery) Delivered(responseStatus int) {
+	now := time.Now()
+	d.Attempts++
+	d.Status = WebhookDeliveryDeliveredStatus
+	d.ResponseStatus = &responseStatus
+	d.LastError = nil
+	d.DeliveredAt = &now
+}
+
+// Failed отмечает неудачную попытку. Следующая попытка назначается на nextAttemptAt,
+// а после maxAttempts попыток доставка переводится в Dead
+func (d *WebhookDelivery) Failed(reason string, responseStatus *int, nextAttemptAt time.Time, maxAttempts int) {
+	d.Attempts++
+	d.LastError = &reason
+	d.ResponseStatus = responseStatus
+	d.NextAttemptAt = nextAttemptAt
+
+	if d.Attempts >= maxAttempts {
+		d.Status = WebhookDeliveryDeadStatus
+	}
+}
+
+// Redeliver возвращает доставку из dead-letter списка в очередь
+func (d *WebhookDelivery) Redeliver() error {
+	if d.Status != WebhookDeliveryDeadStatus {
+		return errors.Wrapf(ErrValidation, "only %s deliveries can be redelivered", WebhookDeliveryDeadStatus)
+	}
+
+	d.Status = WebhookDeliveryPendingStatus
+	d.Attempts = 0
+	d.NextAttemptAt = time.Now()
+
+	return nil
+}
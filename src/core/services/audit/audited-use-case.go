@@ -0,0 +1,107 @@
+package audit
+
+import (
+	"context"
+	"tms/src/core/domain"
+	"tms/src/core/services"
+	"tms/src/core/services/repositories"
+)
+
+// Subject Состояние сущности, записываемое в журнал аудита
+type Subject struct {
+	EntityType     domain.AuditEntityType
+	EntityID       domain.ID
+	OrganizationID domain.ID
+	State          interface{}
+}
+
+// Description описывает, как записать выполнение сценария в журнал аудита
+type Description[D any, R any] struct {
+	Action domain.AuditAction
+	// Actor возвращает фильтр сотрудника, выполняющего сценарий
+	Actor func(dto D) repositories.GetEmployeeDTO
+	// Before возвращает состояние сущности до изменения, не задается для создания
+	Before func(ctx context.Context, dto D) (*Subject, error)
+	// After возвращает состояние сущности после изменения. Если не задан, сущность берется из Before,
+	// а ее состоянием после изменения считается результат сценария
+	After func(ctx context.Context, result R) (*Subject, error)
+	// Skip отключает запись для вызовов, которые ничего не изменяют, например dry-run
+	Skip func(dto D) bool
+}
+
+// AuditedUseCase выполняет сценарий и записывает его в журнал аудита в одной транзакции:
+// если запись не удалась, изменение тоже откатывается
+type AuditedUseCase[D any, R any] struct {
+	useCase            services.UseCase[D, R]
+	description        Description[D, R]
+	transactor         repositories.Transactor
+	employeeRepository repositories.EmployeeRepository
+	auditRepository    repositories.AuditRepository
+}
+
+func New[D any, R any](
+	useCase services.UseCase[D, R],
+	description Description[D, R],
+	transactor repositories.Transactor,
+	employeeRepository repositories.EmployeeRepository,
+	auditRepository repositories.AuditRepository,
+) AuditedUseCase[D, R] {
+	return AuditedUseCase[D, R]{
+		useCase:            useCase,
+		description:        description,
+		transactor:         transactor,
+		employeeRepository: employeeRepository,
+		auditRepository:    auditRepository,
+	}
+}
+
+func (a AuditedUseCase[D, R]) Execute(ctx context.Context, dto D) (R, error) {
+	if a.description.Skip != nil && a.description.Skip(dto) {
+		return a.useCase.Execute(ctx, dto)
+	}
+
+	var result R
+
+	err := a.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		actor, err := a.employeeRepository.Get(ctx, a.description.Actor(dto))
+		if err != nil {
+			return err
+		}
+
+		var before *Subject
+		if a.description.Before != nil {
+			if before, err = a.description.Before(ctx, dto); err != nil {
+				return err
+			}
+		}
+
+		if result, err = a.useCase.Execute(ctx, dto); err != nil {
+			return err
+		}
+
+		after := &Subject{}
+		if a.description.After != nil {
+			if after, err = a.description.After(ctx, result); err != nil {
+				return err
+			}
+		} else {
+			*after = *before
+			after.State = result
+		}
+
+		var beforeState interface{}
+		if before != nil {
+			beforeState = before.State
+		}
+
+		entry, err := domain.NewAuditEntry(a.description.Action, actor.ID, after.OrganizationID, after.EntityType,
+			after.EntityID, beforeState, after.State, RequestID(ctx))
+		if err != nil {
+			return err
+		}
+
+		return a.auditRepository.Save(ctx, *entry)
+	})
+
+	return result, err
+}
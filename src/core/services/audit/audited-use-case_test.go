@@ -0,0 +1,131 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+type fakeTransactor struct{}
+
+func (fakeTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type fakeEmployees struct{}
+
+func (fakeEmployees) Get(_ context.Context, dto repositories.GetEmployeeDTO) (*domain.Employee, error) {
+	return &domain.Employee{ID: domain.ID("id-" + *dto.Username), Username: *dto.Username}, nil
+}
+
+type fakeAudit struct {
+	entries []domain.AuditEntry
+}
+
+func (f *fakeAudit) GetList(context.Context, repositories.GetAuditListDTO) ([]domain.AuditEntry, error) {
+	return f.entries, nil
+}
+
+func (f *fakeAudit) Save(_ context.Context, entry domain.AuditEntry) error {
+	f.entries = append(f.entries, entry)
+	return nil
+}
+
+type renameDTO struct {
+	Username string
+	Name     string
+	DryRun   bool
+}
+
+type renameUseCase struct {
+	err error
+}
+
+func (uc renameUseCase) Execute(_ context.Context, dto renameDTO) (*domain.Tender, error) {
+	if uc.err != nil {
+		return nil, uc.err
+	}
+	return &domain.Tender{ID: "tender-1", OrganizationID: "org-1", Name: domain.TenderName(dto.Name)}, nil
+}
+
+func renameDescription() Description[renameDTO, *domain.Tender] {
+	return Description[renameDTO, *domain.Tender]{
+		Action: domain.AuditEditAction,
+		Actor: func(dto renameDTO) repositories.GetEmployeeDTO {
+			return byUsername(dto.Username)
+		},
+		Before: func(context.Context, renameDTO) (*Subject, error) {
+			return tenderSubject(domain.Tender{ID: "tender-1", OrganizationID: "org-1", Name: "old"}), nil
+		},
+		After: tenderResult,
+		Skip: func(dto renameDTO) bool {
+			return dto.DryRun
+		},
+	}
+}
+
+func TestAuditedUseCase(t *testing.T) {
+	t.Run("records change", func(t *testing.T) {
+		log := &fakeAudit{}
+		uc := New[renameDTO, *domain.Tender](renameUseCase{}, renameDescription(), fakeTransactor{}, fakeEmployees{}, log)
+
+		ctx := WithRequestID(context.Background(), "req-1")
+		_, err := uc.Execute(ctx, renameDTO{Username: "user1", Name: "new"})
+		require.NoError(t, err)
+		require.Len(t, log.entries, 1)
+
+		entry := log.entries[0]
+		assert.Equal(t, domain.ID("id-user1"), entry.ActorID)
+		assert.Equal(t, domain.ID("org-1"), entry.OrganizationID)
+		assert.Equal(t, domain.AuditTenderEntity, entry.EntityType)
+		assert.Equal(t, "req-1", entry.RequestID)
+
+		var before, after domain.Tender
+		require.NoError(t, json.Unmarshal(entry.Before, &before))
+		require.NoError(t, json.Unmarshal(entry.After, &after))
+		assert.Equal(t, domain.TenderName("old"), before.Name)
+		assert.Equal(t, domain.TenderName("new"), after.Name)
+	})
+
+	t.Run("failed use case is not recorded", func(t *testing.T) {
+		log := &fakeAudit{}
+		uc := New[renameDTO, *domain.Tender](renameUseCase{err: domain.ErrNoPermission}, renameDescription(), fakeTransactor{}, fakeEmployees{}, log)
+
+		_, err := uc.Execute(context.Background(), renameDTO{Username: "user1", Name: "new"})
+		assert.True(t, errors.Is(err, domain.ErrNoPermission))
+		assert.Empty(t, log.entries)
+	})
+
+	t.Run("records result as state of Before subject without After", func(t *testing.T) {
+		log := &fakeAudit{}
+		description := renameDescription()
+		description.After = nil
+		uc := New[renameDTO, *domain.Tender](renameUseCase{}, description, fakeTransactor{}, fakeEmployees{}, log)
+
+		_, err := uc.Execute(context.Background(), renameDTO{Username: "user1", Name: "new"})
+		require.NoError(t, err)
+		require.Len(t, log.entries, 1)
+
+		entry := log.entries[0]
+		assert.Equal(t, domain.ID("tender-1"), entry.EntityID)
+		assert.Equal(t, domain.ID("org-1"), entry.OrganizationID)
+
+		var after domain.Tender
+		require.NoError(t, json.Unmarshal(entry.After, &after))
+		assert.Equal(t, domain.TenderName("new"), after.Name)
+	})
+
+	t.Run("dry run is not recorded", func(t *testing.T) {
+		log := &fakeAudit{}
+		uc := New[renameDTO, *domain.Tender](renameUseCase{}, renameDescription(), fakeTransactor{}, fakeEmployees{}, log)
+
+		_, err := uc.Execute(context.Background(), renameDTO{Username: "user1", Name: "new", DryRun: true})
+		require.NoError(t, err)
+		assert.Empty(t, log.entries)
+	})
+}
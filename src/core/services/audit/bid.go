@@ -0,0 +1,128 @@
+package audit
+
+import (
+	"context"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+	usecases "tms/src/core/services/use-cases/bid"
+)
+
+// bidSubject относит bid к организации тендера, на который оно подано
+func bidSubject(ctx context.Context, tenderRepository repositories.TenderRepository, bid domain.Bid) (*Subject, error) {
+	tender, err := tenderRepository.Get(ctx, repositories.GetTenderDTO{
+		ID: bid.TenderID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Subject{
+		EntityType:     domain.AuditBidEntity,
+		EntityID:       bid.ID,
+		OrganizationID: tender.OrganizationID,
+		State:          bid,
+	}, nil
+}
+
+func bidResult(tenderRepository repositories.TenderRepository) func(context.Context, *domain.Bid) (*Subject, error) {
+	return func(ctx context.Context, bid *domain.Bid) (*Subject, error) {
+		return bidSubject(ctx, tenderRepository, *bid)
+	}
+}
+
+// loadBid возвращает Before, загружающий предложение с идентификатором id(dto)
+func loadBid[D any](
+	bidRepository repositories.BidRepository,
+	tenderRepository repositories.TenderRepository,
+	id func(dto D) string,
+) func(context.Context, D) (*Subject, error) {
+	return func(ctx context.Context, dto D) (*Subject, error) {
+		bid, err := bidRepository.Get(ctx, repositories.GetBidDTO{
+			ID: domain.ID(id(dto)),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return bidSubject(ctx, tenderRepository, *bid)
+	}
+}
+
+func CreateBid(tenderRepository repositories.TenderRepository) Description[usecases.CreateBidDTO, *domain.Bid] {
+	return Description[usecases.CreateBidDTO, *domain.Bid]{
+		Action: domain.AuditCreateAction,
+		Actor: func(dto usecases.CreateBidDTO) repositories.GetEmployeeDTO {
+			authorID := domain.ID(dto.AuthorID)
+			return repositories.GetEmployeeDTO{ID: &authorID}
+		},
+		After: bidResult(tenderRepository),
+	}
+}
+
+func EditBid(
+	bidRepository repositories.BidRepository,
+	tenderRepository repositories.TenderRepository,
+) Description[usecases.EditBidDTO, *domain.Bid] {
+	return Description[usecases.EditBidDTO, *domain.Bid]{
+		Action: domain.AuditEditAction,
+		Actor: func(dto usecases.EditBidDTO) repositories.GetEmployeeDTO {
+			return byUsername(dto.Username)
+		},
+		Before: loadBid(bidRepository, tenderRepository, func(dto usecases.EditBidDTO) string {
+			return dto.BidID
+		}),
+		After: bidResult(tenderRepository),
+	}
+}
+
+func RollbackBid(
+	bidRepository repositories.BidRepository,
+	tenderRepository repositories.TenderRepository,
+) Description[usecases.RollbackBidDTO, *usecases.RollbackBidResult] {
+	return Description[usecases.RollbackBidDTO, *usecases.RollbackBidResult]{
+		Action: domain.AuditRollbackAction,
+		Actor: func(dto usecases.RollbackBidDTO) repositories.GetEmployeeDTO {
+			return byUsername(dto.Username)
+		},
+		Before: loadBid(bidRepository, tenderRepository, func(dto usecases.RollbackBidDTO) string {
+			return dto.BidID
+		}),
+		After: func(ctx context.Context, result *usecases.RollbackBidResult) (*Subject, error) {
+			return bidSubject(ctx, tenderRepository, *result.Bid)
+		},
+		Skip: func(dto usecases.RollbackBidDTO) bool {
+			return dto.DryRun
+		},
+	}
+}
+
+func ChangeBidStatus(
+	bidRepository repositories.BidRepository,
+	tenderRepository repositories.TenderRepository,
+) Description[usecases.ChangeBidStatusDTO, *domain.Bid] {
+	return Description[usecases.ChangeBidStatusDTO, *domain.Bid]{
+		Action: domain.AuditStatusChangeAction,
+		Actor: func(dto usecases.ChangeBidStatusDTO) repositories.GetEmployeeDTO {
+			return byUsername(dto.Username)
+		},
+		Before: loadBid(bidRepository, tenderRepository, func(dto usecases.ChangeBidStatusDTO) string {
+			return dto.BidID
+		}),
+		After: bidResult(tenderRepository),
+	}
+}
+
+func SubmitDecision(
+	bidRepository repositories.BidRepository,
+	tenderRepository repositories.TenderRepository,
+) Description[usecases.SubmitDecisionDTO, *domain.Bid] {
+	return Description[usecases.SubmitDecisionDTO, *domain.Bid]{
+		Action: domain.AuditDecisionAction,
+		Actor: func(dto usecases.SubmitDecisionDTO) repositories.GetEmployeeDTO {
+			return byUsername(dto.Username)
+		},
+		Before: loadBid(bidRepository, tenderRepository, func(dto usecases.SubmitDecisionDTO) string {
+			return dto.BidID
+		}),
+		After: bidResult(tenderRepository),
+	}
+}
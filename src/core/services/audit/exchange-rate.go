@@ -0,0 +1,50 @@
+package audit
+
+import (
+	"context"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+	usecases "tms/src/core/services/use-cases/exchange-rate"
+)
+
+func exchangeRateSubject(rate domain.ExchangeRate) *Subject {
+	return &Subject{
+		EntityType:     domain.AuditExchangeRateEntity,
+		EntityID:       rate.ID,
+		OrganizationID: rate.OrganizationID,
+		State:          rate,
+	}
+}
+
+func CreateExchangeRate() Description[usecases.CreateExchangeRateDTO, *domain.ExchangeRate] {
+	return Description[usecases.CreateExchangeRateDTO, *domain.ExchangeRate]{
+		Action: domain.AuditCreateAction,
+		Actor: func(dto usecases.CreateExchangeRateDTO) repositories.GetEmployeeDTO {
+			return byUsername(dto.Username)
+		},
+		After: func(_ context.Context, rate *domain.ExchangeRate) (*Subject, error) {
+			return exchangeRateSubject(*rate), nil
+		},
+	}
+}
+
+func DeleteExchangeRate(exchangeRateRepository repositories.ExchangeRateRepository) Description[usecases.DeleteExchangeRateDTO, *domain.ExchangeRate] {
+	return Description[usecases.DeleteExchangeRateDTO, *domain.ExchangeRate]{
+		Action: domain.AuditDeleteAction,
+		Actor: func(dto usecases.DeleteExchangeRateDTO) repositories.GetEmployeeDTO {
+			return byUsername(dto.Username)
+		},
+		Before: func(ctx context.Context, dto usecases.DeleteExchangeRateDTO) (*Subject, error) {
+			rate, err := exchangeRateRepository.Get(ctx, domain.ID(dto.ExchangeRateID))
+			if err != nil {
+				return nil, err
+			}
+			return exchangeRateSubject(*rate), nil
+		},
+		After: func(_ context.Context, rate *domain.ExchangeRate) (*Subject, error) {
+			subject := exchangeRateSubject(*rate)
+			subject.State = nil
+			return subject, nil
+		},
+	}
+}
@@ -0,0 +1,16 @@
+package audit
+
+import "context"
+
+type requestIDKey struct{}
+
+// WithRequestID сохраняет идентификатор запроса, который попадет в записи журнала аудита
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestID возвращает идентификатор запроса из ctx или пустую строку
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
@@ -0,0 +1,110 @@
+package audit
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+	usecases "tms/src/core/services/use-cases/saved-search"
+)
+
+// savedSearchSubject относит сохраненный поиск к организации, за которую отвечает его владелец.
+// Поиски сотрудников, не отвечающих за организацию, записываются без нее
+func savedSearchSubject(
+	ctx context.Context,
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository,
+	search domain.SavedSearch,
+) (*Subject, error) {
+	subject := &Subject{
+		EntityType: domain.AuditSavedSearchEntity,
+		EntityID:   search.ID,
+		State:      search,
+	}
+
+	orgResponsible, err := orgResponsibleRepository.Get(ctx, repositories.GetOrganizationResponsibleDTO{
+		EmployeeID: search.EmployeeID,
+	})
+	if err != nil {
+		if errors.Is(errors.Cause(err), domain.ErrNotFound) {
+			return subject, nil
+		}
+		return nil, err
+	}
+	subject.OrganizationID = orgResponsible.OrganizationID
+
+	return subject, nil
+}
+
+func savedSearchResult(
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository,
+) func(context.Context, *domain.SavedSearch) (*Subject, error) {
+	return func(ctx context.Context, search *domain.SavedSearch) (*Subject, error) {
+		return savedSearchSubject(ctx, orgResponsibleRepository, *search)
+	}
+}
+
+// loadSavedSearch возвращает Before, загружающий сохраненный поиск с идентификатором id(dto)
+func loadSavedSearch[D any](
+	savedSearchRepository repositories.SavedSearchRepository,
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository,
+	id func(dto D) string,
+) func(context.Context, D) (*Subject, error) {
+	return func(ctx context.Context, dto D) (*Subject, error) {
+		search, err := savedSearchRepository.Get(ctx, domain.ID(id(dto)))
+		if err != nil {
+			return nil, err
+		}
+		return savedSearchSubject(ctx, orgResponsibleRepository, *search)
+	}
+}
+
+func CreateSavedSearch(
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository,
+) Description[usecases.CreateSavedSearchDTO, *domain.SavedSearch] {
+	return Description[usecases.CreateSavedSearchDTO, *domain.SavedSearch]{
+		Action: domain.AuditCreateAction,
+		Actor: func(dto usecases.CreateSavedSearchDTO) repositories.GetEmployeeDTO {
+			return byUsername(dto.Username)
+		},
+		After: savedSearchResult(orgResponsibleRepository),
+	}
+}
+
+func EditSavedSearch(
+	savedSearchRepository repositories.SavedSearchRepository,
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository,
+) Description[usecases.EditSavedSearchDTO, *domain.SavedSearch] {
+	return Description[usecases.EditSavedSearchDTO, *domain.SavedSearch]{
+		Action: domain.AuditEditAction,
+		Actor: func(dto usecases.EditSavedSearchDTO) repositories.GetEmployeeDTO {
+			return byUsername(dto.Username)
+		},
+		Before: loadSavedSearch(savedSearchRepository, orgResponsibleRepository, func(dto usecases.EditSavedSearchDTO) string {
+			return dto.SavedSearchID
+		}),
+		After: savedSearchResult(orgResponsibleRepository),
+	}
+}
+
+func DeleteSavedSearch(
+	savedSearchRepository repositories.SavedSearchRepository,
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository,
+) Description[usecases.DeleteSavedSearchDTO, *domain.SavedSearch] {
+	return Description[usecases.DeleteSavedSearchDTO, *domain.SavedSearch]{
+		Action: domain.AuditDeleteAction,
+		Actor: func(dto usecases.DeleteSavedSearchDTO) repositories.GetEmployeeDTO {
+			return byUsername(dto.Username)
+		},
+		Before: loadSavedSearch(savedSearchRepository, orgResponsibleRepository, func(dto usecases.DeleteSavedSearchDTO) string {
+			return dto.SavedSearchID
+		}),
+		After: func(ctx context.Context, search *domain.SavedSearch) (*Subject, error) {
+			subject, err := savedSearchSubject(ctx, orgResponsibleRepository, *search)
+			if err != nil {
+				return nil, err
+			}
+			subject.State = nil
+			return subject, nil
+		},
+	}
+}
@@ -0,0 +1,82 @@
+package audit
+
+import (
+	"context"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+	bidusecases "tms/src/core/services/use-cases/bid"
+	tenderusecases "tms/src/core/services/use-cases/tender"
+)
+
+// SetTenderCriteria записывает критерии оценки как состояние тендера
+func SetTenderCriteria(
+	tenderRepository repositories.TenderRepository,
+	scoringRepository repositories.ScoringRepository,
+) Description[tenderusecases.SetTenderCriteriaDTO, domain.ScoringCriteria] {
+	return Description[tenderusecases.SetTenderCriteriaDTO, domain.ScoringCriteria]{
+		Action: domain.AuditCriteriaAction,
+		Actor: func(dto tenderusecases.SetTenderCriteriaDTO) repositories.GetEmployeeDTO {
+			return byUsername(dto.Username)
+		},
+		Before: func(ctx context.Context, dto tenderusecases.SetTenderCriteriaDTO) (*Subject, error) {
+			tender, err := tenderRepository.Get(ctx, repositories.GetTenderDTO{
+				ID: domain.ID(dto.TenderID),
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			criteria, err := scoringRepository.GetCriteria(ctx, tender.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			subject := tenderSubject(*tender)
+			subject.State = criteria
+			return subject, nil
+		},
+	}
+}
+
+// SubmitBidScores записывает оценки ответственного как состояние предложения
+func SubmitBidScores(
+	employeeRepository repositories.EmployeeRepository,
+	bidRepository repositories.BidRepository,
+	tenderRepository repositories.TenderRepository,
+	scoringRepository repositories.ScoringRepository,
+) Description[bidusecases.SubmitBidScoresDTO, []domain.BidScore] {
+	return Description[bidusecases.SubmitBidScoresDTO, []domain.BidScore]{
+		Action: domain.AuditScoreAction,
+		Actor: func(dto bidusecases.SubmitBidScoresDTO) repositories.GetEmployeeDTO {
+			return byUsername(dto.Username)
+		},
+		Before: func(ctx context.Context, dto bidusecases.SubmitBidScoresDTO) (*Subject, error) {
+			employee, err := employeeRepository.Get(ctx, byUsername(dto.Username))
+			if err != nil {
+				return nil, err
+			}
+
+			bid, err := bidRepository.Get(ctx, repositories.GetBidDTO{
+				ID: domain.ID(dto.BidID),
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			scores, err := scoringRepository.GetScores(ctx, repositories.GetBidScoresDTO{
+				BidID:       &bid.ID,
+				EvaluatorID: &employee.ID,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			subject, err := bidSubject(ctx, tenderRepository, *bid)
+			if err != nil {
+				return nil, err
+			}
+			subject.State = scores
+			return subject, nil
+		},
+	}
+}
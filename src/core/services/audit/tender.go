@@ -0,0 +1,105 @@
+package audit
+
+import (
+	"context"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+	usecases "tms/src/core/services/use-cases/tender"
+)
+
+func tenderSubject(tender domain.Tender) *Subject {
+	return &Subject{
+		EntityType:     domain.AuditTenderEntity,
+		EntityID:       tender.ID,
+		OrganizationID: tender.OrganizationID,
+		State:          tender,
+	}
+}
+
+func tenderResult(_ context.Context, tender *domain.Tender) (*Subject, error) {
+	return tenderSubject(*tender), nil
+}
+
+// loadTender возвращает Before, загружающий тендер с идентификатором id(dto)
+func loadTender[D any](tenderRepository repositories.TenderRepository, id func(dto D) string) func(context.Context, D) (*Subject, error) {
+	return func(ctx context.Context, dto D) (*Subject, error) {
+		tender, err := tenderRepository.Get(ctx, repositories.GetTenderDTO{
+			ID: domain.ID(id(dto)),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return tenderSubject(*tender), nil
+	}
+}
+
+func byUsername(username string) repositories.GetEmployeeDTO {
+	return repositories.GetEmployeeDTO{Username: &username}
+}
+
+func CreateTender() Description[usecases.CreateTenderDTO, *domain.Tender] {
+	return Description[usecases.CreateTenderDTO, *domain.Tender]{
+		Action: domain.AuditCreateAction,
+		Actor: func(dto usecases.CreateTenderDTO) repositories.GetEmployeeDTO {
+			return byUsername(dto.CreatorUsername)
+		},
+		After: tenderResult,
+	}
+}
+
+func EditTender(tenderRepository repositories.TenderRepository) Description[usecases.EditTenderUseCaseDTO, *domain.Tender] {
+	return Description[usecases.EditTenderUseCaseDTO, *domain.Tender]{
+		Action: domain.AuditEditAction,
+		Actor: func(dto usecases.EditTenderUseCaseDTO) repositories.GetEmployeeDTO {
+			return byUsername(dto.Username)
+		},
+		Before: loadTender(tenderRepository, func(dto usecases.EditTenderUseCaseDTO) string {
+			return dto.TenderID
+		}),
+		After: tenderResult,
+	}
+}
+
+func RollbackTender(tenderRepository repositories.TenderRepository) Description[usecases.RollBackTenderUseCaseDTO, *usecases.RollBackTenderResult] {
+	return Description[usecases.RollBackTenderUseCaseDTO, *usecases.RollBackTenderResult]{
+		Action: domain.AuditRollbackAction,
+		Actor: func(dto usecases.RollBackTenderUseCaseDTO) repositories.GetEmployeeDTO {
+			return byUsername(dto.Username)
+		},
+		Before: loadTender(tenderRepository, func(dto usecases.RollBackTenderUseCaseDTO) string {
+			return dto.TenderID
+		}),
+		After: func(_ context.Context, result *usecases.RollBackTenderResult) (*Subject, error) {
+			return tenderSubject(*result.Tender), nil
+		},
+		Skip: func(dto usecases.RollBackTenderUseCaseDTO) bool {
+			return dto.DryRun
+		},
+	}
+}
+
+func ChangeTenderStatus(tenderRepository repositories.TenderRepository) Description[usecases.ChangeTenderStatusDTO, *domain.Tender] {
+	return Description[usecases.ChangeTenderStatusDTO, *domain.Tender]{
+		Action: domain.AuditStatusChangeAction,
+		Actor: func(dto usecases.ChangeTenderStatusDTO) repositories.GetEmployeeDTO {
+			return byUsername(dto.Username)
+		},
+		Before: loadTender(tenderRepository, func(dto usecases.ChangeTenderStatusDTO) string {
+			return dto.TenderID
+		}),
+		After: tenderResult,
+	}
+}
+
+func ScheduleTender(tenderRepository repositories.TenderRepository) Description[usecases.ScheduleTenderDTO, *domain.Tender] {
+	return Description[usecases.ScheduleTenderDTO, *domain.Tender]{
+		Action: domain.AuditScheduleAction,
+		Actor: func(dto usecases.ScheduleTenderDTO) repositories.GetEmployeeDTO {
+			return byUsername(dto.Username)
+		},
+		Before: loadTender(tenderRepository, func(dto usecases.ScheduleTenderDTO) string {
+			return dto.TenderID
+		}),
+		After: tenderResult,
+	}
+}
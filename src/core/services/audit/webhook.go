@@ -0,0 +1,73 @@
+package audit
+
+import (
+	"context"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+	usecases "tms/src/core/services/use-cases/webhook"
+)
+
+func webhookSubject(webhook domain.Webhook) *Subject {
+	return &Subject{
+		EntityType:     domain.AuditWebhookEntity,
+		EntityID:       webhook.ID,
+		OrganizationID: webhook.OrganizationID,
+		State:          webhook,
+	}
+}
+
+func webhookResult(_ context.Context, webhook *domain.Webhook) (*Subject, error) {
+	return webhookSubject(*webhook), nil
+}
+
+// loadWebhook возвращает Before, загружающий вебхук с идентификатором id(dto)
+func loadWebhook[D any](webhookRepository repositories.WebhookRepository, id func(dto D) string) func(context.Context, D) (*Subject, error) {
+	return func(ctx context.Context, dto D) (*Subject, error) {
+		webhook, err := webhookRepository.Get(ctx, domain.ID(id(dto)))
+		if err != nil {
+			return nil, err
+		}
+		return webhookSubject(*webhook), nil
+	}
+}
+
+func CreateWebhook() Description[usecases.CreateWebhookDTO, *domain.Webhook] {
+	return Description[usecases.CreateWebhookDTO, *domain.Webhook]{
+		Action: domain.AuditCreateAction,
+		Actor: func(dto usecases.CreateWebhookDTO) repositories.GetEmployeeDTO {
+			return byUsername(dto.Username)
+		},
+		After: webhookResult,
+	}
+}
+
+func EditWebhook(webhookRepository repositories.WebhookRepository) Description[usecases.EditWebhookDTO, *domain.Webhook] {
+	return Description[usecases.EditWebhookDTO, *domain.Webhook]{
+		Action: domain.AuditEditAction,
+		Actor: func(dto usecases.EditWebhookDTO) repositories.GetEmployeeDTO {
+			return byUsername(dto.Username)
+		},
+		Before: loadWebhook(webhookRepository, func(dto usecases.EditWebhookDTO) string {
+			return dto.WebhookID
+		}),
+		After: webhookResult,
+	}
+}
+
+// DeleteWebhook записывает удаление вебхука с пустым состоянием после изменения
+func DeleteWebhook(webhookRepository repositories.WebhookRepository) Description[usecases.DeleteWebhookDTO, *domain.Webhook] {
+	return Description[usecases.DeleteWebhookDTO, *domain.Webhook]{
+		Action: domain.AuditDeleteAction,
+		Actor: func(dto usecases.DeleteWebhookDTO) repositories.GetEmployeeDTO {
+			return byUsername(dto.Username)
+		},
+		Before: loadWebhook(webhookRepository, func(dto usecases.DeleteWebhookDTO) string {
+			return dto.WebhookID
+		}),
+		After: func(ctx context.Context, webhook *domain.Webhook) (*Subject, error) {
+			subject := webhookSubject(*webhook)
+			subject.State = nil
+			return subject, nil
+		},
+	}
+}
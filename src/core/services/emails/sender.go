@@ -0,0 +1,123 @@
+package emails
+
+import (
+	"context"
+	"log/slog"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/events"
+	"tms/src/core/services/repositories"
+	"tms/src/pkg/jobs"
+	"tms/src/pkg/logger/sl"
+	"tms/src/pkg/mailer"
+)
+
+const SendEmailsJob = "send_emails"
+
+type SenderConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+	// MaxAttempts после стольких неудачных попыток письмо больше не отправляется
+	MaxAttempts int
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	// Timeout отправки одного письма
+	Timeout time.Duration
+	// Lease на это время выбранные письма скрываются от других отправителей
+	Lease time.Duration
+}
+
+// Sender периодической задачей отправляет письма из очереди, чтобы сценарии не ждали почтовый сервер
+type Sender struct {
+	emailRepository repositories.EmailRepository
+	mailer          mailer.Mailer
+	cfg             SenderConfig
+	log             *slog.Logger
+}
+
+func NewSender(
+	emailRepository repositories.EmailRepository,
+	mailer mailer.Mailer,
+	cfg SenderConfig,
+	log *slog.Logger,
+) *Sender {
+	return &Sender{
+		emailRepository: emailRepository,
+		mailer:          mailer,
+		cfg:             cfg,
+		log:             log,
+	}
+}
+
+// Register регистрирует отправку как периодическую задачу, выполняемую одним экземпляром приложения раз в PollInterval
+func (s *Sender) Register(runner *jobs.Runner) {
+	runner.Every(SendEmailsJob, s.cfg.PollInterval, s.Handle)
+}
+
+// Handle отправляет пачки писем, пока не будет выбрана неполная или не истечет аренда задачи
+func (s *Sender) Handle(ctx context.Context, _ jobs.Job) error {
+	for {
+		n, err := s.Process(ctx)
+		if err != nil {
+			return err
+		}
+		if n < s.cfg.BatchSize || ctx.Err() != nil {
+			return nil
+		}
+	}
+}
+
+// Process отправляет одну пачку писем и возвращает их кол-во.
+// Письма отправляются вне транзакции, результат каждого сохраняется отдельно.
+// Если ctx отменен, оставшиеся письма будут выбраны снова после истечения Lease
+func (s *Sender) Process(ctx context.Context) (int, error) {
+	emails, err := s.emailRepository.Claim(ctx, repositories.ClaimEmailsDTO{
+		Limit: s.cfg.BatchSize,
+		Lease: s.cfg.Lease,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var result error
+
+	for _, e := range emails {
+		if ctx.Err() != nil {
+			break
+		}
+
+		s.send(ctx, &e)
+
+		// Результат сохраняется и после отмены ctx, иначе отправленное письмо уйдет повторно
+		if err := s.emailRepository.Save(context.WithoutCancel(ctx), e); err != nil && result == nil {
+			result = err
+		}
+	}
+
+	return len(emails), result
+}
+
+// send выполняет одну попытку отправки и отмечает ее результат в e
+func (s *Sender) send(ctx context.Context, e *domain.Email) {
+	sendCtx, cancel := context.WithTimeout(ctx, s.cfg.Timeout)
+	defer cancel()
+
+	err := s.mailer.Send(sendCtx, mailer.Message{To: e.To, Subject: e.Subject, Body: e.Body})
+	if err == nil {
+		e.Sent()
+		return
+	}
+
+	next := time.Now().Add(events.Backoff(e.Attempts+1, s.cfg.BaseBackoff, s.cfg.MaxBackoff))
+	e.Failed(err.Error(), next, s.cfg.MaxAttempts)
+
+	log := s.log.With(
+		slog.String("emailId", string(e.ID)),
+		slog.Int("attempt", e.Attempts),
+		sl.Err(err))
+	if e.Status == domain.EmailDeadStatus {
+		log.Error("email dropped after max attempts")
+		return
+	}
+	log.Warn("email sending failed")
+}
@@ -0,0 +1,67 @@
+package emails
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"log/slog"
+	"testing"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+	"tms/src/pkg/mailer"
+)
+
+// fakeQueue хранит одно письмо, Claim возвращает его, пока оно ожидает отправки
+type fakeQueue struct {
+	repositories.EmailRepository
+	email domain.Email
+	lease time.Duration
+}
+
+func (f *fakeQueue) Claim(_ context.Context, dto repositories.ClaimEmailsDTO) ([]domain.Email, error) {
+	f.lease = dto.Lease
+	if f.email.Status != domain.EmailPendingStatus {
+		return nil, nil
+	}
+	return []domain.Email{f.email}, nil
+}
+
+func (f *fakeQueue) Save(_ context.Context, email domain.Email) error {
+	f.email = email
+	return nil
+}
+
+type fakeMailer struct {
+	errs []error
+}
+
+func (f *fakeMailer) Send(context.Context, mailer.Message) error {
+	err := f.errs[0]
+	f.errs = f.errs[1:]
+	return err
+}
+
+func TestSender_Process(t *testing.T) {
+	queue := &fakeQueue{email: domain.NewEmail("employee", "event", "employee@example.com", "Тема", "Текст")}
+	sender := NewSender(queue, &fakeMailer{errs: []error{errors.New("smtp unavailable"), nil}}, SenderConfig{
+		BatchSize:   10,
+		MaxAttempts: 3,
+		Timeout:     time.Second,
+		Lease:       time.Minute,
+	}, slog.Default())
+
+	n, err := sender.Process(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, time.Minute, queue.lease)
+	assert.Equal(t, domain.EmailPendingStatus, queue.email.Status)
+	assert.Equal(t, 1, queue.email.Attempts)
+
+	// Повторная попытка становится доступна после backoff, в fake очереди письмо выбирается сразу
+	_, err = sender.Process(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, domain.EmailSentStatus, queue.email.Status)
+	assert.Equal(t, 2, queue.email.Attempts)
+}
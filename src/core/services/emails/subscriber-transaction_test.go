@@ -0,0 +1,19 @@
+package emails
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"tms/src/core/data/fixtures"
+	"tms/src/core/domain"
+)
+
+// Подписчики вызываются в транзакции relay, где все запросы идут через одно соединение
+func TestTenderClosedLetters_WithinTransaction(t *testing.T) {
+	fixtures.ClosedTender(t, []domain.ID{"first", "second"}, func(ctx context.Context, r fixtures.Repositories, event domain.TenderStatusChanged) {
+		letters, err := tenderClosedLetters(ctx, r.Tenders, r.Bids, event)
+		require.NoError(t, err)
+		assert.Len(t, letters, 2)
+	})
+}
@@ -0,0 +1,163 @@
+package emails
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"tms/src/core/domain"
+	"tms/src/core/services/events"
+	"tms/src/core/services/repositories"
+)
+
+// letter Письмо, которое нужно отправить сотруднику
+type letter struct {
+	recipientID domain.ID
+	kind        domain.NotificationKind
+	data        templateData
+}
+
+// Subscriber подписчик событий, ставящий в очередь письма автору предложения о решении по нему
+// и авторам предложений о закрытии тендера. Письма не отправляются сотрудникам без адреса
+// и отключившим их в настройках. Повторный вызов для того же события не создает новых писем
+func Subscriber(
+	emailRepository repositories.EmailRepository,
+	employeeRepository repositories.EmployeeRepository,
+	preferenceRepository repositories.NotificationPreferenceRepository,
+	tenderRepository repositories.TenderRepository,
+	bidRepository repositories.BidRepository,
+) events.Handler {
+	return func(ctx context.Context, event domain.Event) error {
+		var (
+			letters []letter
+			err     error
+		)
+
+		switch e := event.(type) {
+		case domain.DecisionMade:
+			letters, err = decisionLetters(ctx, tenderRepository, bidRepository, e)
+		case domain.TenderStatusChanged:
+			if e.To == domain.TenderClosedStatus {
+				letters, err = tenderClosedLetters(ctx, tenderRepository, bidRepository, e)
+			}
+		}
+		if err != nil {
+			return err
+		}
+
+		emails := make([]domain.Email, 0, len(letters))
+
+		for _, l := range letters {
+			email, err := compose(ctx, employeeRepository, preferenceRepository, event, l)
+			if err != nil {
+				return err
+			}
+			if email != nil {
+				emails = append(emails, *email)
+			}
+		}
+
+		return emailRepository.Append(ctx, emails...)
+	}
+}
+
+func decisionLetters(
+	ctx context.Context,
+	tenderRepository repositories.TenderRepository,
+	bidRepository repositories.BidRepository,
+	e domain.DecisionMade,
+) ([]letter, error) {
+	bid, err := bidRepository.Get(ctx, repositories.GetBidDTO{ID: e.BidID})
+	if err != nil {
+		return nil, err
+	}
+
+	tender, err := tenderRepository.Get(ctx, repositories.GetTenderDTO{ID: e.TenderID})
+	if err != nil {
+		return nil, err
+	}
+
+	return []letter{{
+		recipientID: bid.AuthorID,
+		kind:        domain.NotificationDecisionMadeKind,
+		data:        templateData{TenderName: tender.Name, BidName: bid.Name, Decision: e.Status},
+	}}, nil
+}
+
+func tenderClosedLetters(
+	ctx context.Context,
+	tenderRepository repositories.TenderRepository,
+	bidRepository repositories.BidRepository,
+	e domain.TenderStatusChanged,
+) ([]letter, error) {
+	tender, err := tenderRepository.Get(ctx, repositories.GetTenderDTO{ID: e.TenderID})
+	if err != nil {
+		return nil, err
+	}
+
+	bids, err := bidRepository.GetList(ctx, repositories.GetBidListDTO{TenderID: &tender.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	letters := make([]letter, 0, len(bids))
+	seen := make(map[domain.ID]struct{}, len(bids))
+
+	for _, bid := range bids {
+		if _, ok := seen[bid.AuthorID]; ok {
+			continue
+		}
+		seen[bid.AuthorID] = struct{}{}
+		letters = append(letters, letter{
+			recipientID: bid.AuthorID,
+			kind:        domain.NotificationTenderClosedKind,
+			data:        templateData{TenderName: tender.Name},
+		})
+	}
+
+	return letters, nil
+}
+
+// compose готовит письмо l на языке получателя или возвращает nil, если письмо отправлять не нужно
+func compose(
+	ctx context.Context,
+	employeeRepository repositories.EmployeeRepository,
+	preferenceRepository repositories.NotificationPreferenceRepository,
+	event domain.Event,
+	l letter,
+) (*domain.Email, error) {
+	employee, err := employeeRepository.Get(ctx, repositories.GetEmployeeDTO{ID: &l.recipientID})
+	if err != nil {
+		if errors.Is(errors.Cause(err), domain.ErrUserNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if employee.Email == nil {
+		return nil, nil
+	}
+
+	preferences, err := preferenceRepository.Get(ctx, employee.ID)
+	if err != nil {
+		if !errors.Is(errors.Cause(err), domain.ErrNotFound) {
+			return nil, err
+		}
+		defaults := domain.DefaultNotificationPreferences(employee.ID)
+		preferences = &defaults
+	}
+	if !preferences.EmailEnabled {
+		return nil, nil
+	}
+
+	l.data.Name = employee.Username
+	if employee.FirstName != nil && *employee.FirstName != "" {
+		l.data.Name = *employee.FirstName
+	}
+
+	subject, body, err := render(l.kind, preferences.Language, l.data)
+	if err != nil {
+		return nil, err
+	}
+
+	email := domain.NewEmail(employee.ID, event.Meta().EventID, *employee.Email, subject, body)
+
+	return &email, nil
+}
@@ -0,0 +1,138 @@
+package emails
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+type fakeEmails struct {
+	repositories.EmailRepository
+	appended []domain.Email
+}
+
+func (f *fakeEmails) Append(_ context.Context, emails ...domain.Email) error {
+	f.appended = append(f.appended, emails...)
+	return nil
+}
+
+type fakeEmployees struct {
+	repositories.EmployeeRepository
+	employees []domain.Employee
+}
+
+func (f fakeEmployees) Get(_ context.Context, dto repositories.GetEmployeeDTO) (*domain.Employee, error) {
+	for _, e := range f.employees {
+		if e.ID == *dto.ID {
+			return &e, nil
+		}
+	}
+	return nil, domain.ErrUserNotFound
+}
+
+type fakePreferences struct {
+	repositories.NotificationPreferenceRepository
+	preferences []domain.NotificationPreferences
+}
+
+func (f fakePreferences) Get(_ context.Context, employeeID domain.ID) (*domain.NotificationPreferences, error) {
+	for _, p := range f.preferences {
+		if p.EmployeeID == employeeID {
+			return &p, nil
+		}
+	}
+	return nil, domain.ErrNotFound
+}
+
+type fakeTenders struct {
+	repositories.TenderRepository
+	tender domain.Tender
+}
+
+func (f fakeTenders) Get(context.Context, repositories.GetTenderDTO) (*domain.Tender, error) {
+	return &f.tender, nil
+}
+
+type fakeBids struct {
+	repositories.BidRepository
+	bids []domain.Bid
+}
+
+func (f fakeBids) Get(_ context.Context, dto repositories.GetBidDTO) (*domain.Bid, error) {
+	for _, b := range f.bids {
+		if b.ID == dto.ID {
+			return &b, nil
+		}
+	}
+	return nil, domain.ErrNotFound
+}
+
+func (f fakeBids) GetList(context.Context, repositories.GetBidListDTO) ([]domain.Bid, error) {
+	return f.bids, nil
+}
+
+func TestSubscriber(t *testing.T) {
+	executor := domain.OrganizationResponsible{OrganizationID: "org", UserID: "responsible"}
+	tender, err := domain.NewTender("Доставка", "Описание", string(domain.TenderDeliveryServiceType), "org", nil, nil, nil, executor)
+	require.NoError(t, err)
+
+	address := func(s string) *string { return &s }
+	name := "Иван"
+	employees := []domain.Employee{
+		{ID: "ru", Username: "ru", FirstName: &name, Email: address("ru@example.com")},
+		{ID: "en", Username: "en", Email: address("en@example.com")},
+		{ID: "muted", Username: "muted", Email: address("muted@example.com")},
+		{ID: "no-email", Username: "no-email"},
+	}
+	english := domain.DefaultNotificationPreferences("en")
+	english.Language = domain.LanguageEnglish
+	muted := domain.DefaultNotificationPreferences("muted")
+	muted.EmailEnabled = false
+
+	bids := make([]domain.Bid, 0, len(employees))
+	for _, e := range employees {
+		bid, err := domain.NewBid("Предложение "+e.Username, "Описание", string(domain.BidAuthorUserType), domain.Money{Amount: 100000, Currency: domain.RUBCurrency}, tender.ID, e.ID)
+		require.NoError(t, err)
+		bids = append(bids, *bid)
+	}
+
+	emails := &fakeEmails{}
+	handle := Subscriber(
+		emails,
+		fakeEmployees{employees: employees},
+		fakePreferences{preferences: []domain.NotificationPreferences{english, muted}},
+		fakeTenders{tender: *tender},
+		fakeBids{bids: bids},
+	)
+
+	t.Run("decision is mailed in recipient language", func(t *testing.T) {
+		emails.appended = nil
+
+		event := domain.DecisionMade{BidID: bids[0].ID, TenderID: tender.ID, Status: domain.DecisionApprovedStatus}
+		require.NoError(t, handle(context.Background(), event))
+
+		require.Len(t, emails.appended, 1)
+		email := emails.appended[0]
+		assert.Equal(t, "ru@example.com", email.To)
+		assert.Equal(t, "Решение по предложению «Предложение ru»", email.Subject)
+		assert.Contains(t, email.Body, "Здравствуйте, Иван!")
+		assert.Contains(t, email.Body, "принято решение: одобрено")
+		assert.Equal(t, domain.EmailPendingStatus, email.Status)
+	})
+
+	t.Run("tender closing skips muted authors and authors without email", func(t *testing.T) {
+		emails.appended = nil
+
+		event := domain.TenderStatusChanged{TenderID: tender.ID, OrganizationID: "org", To: domain.TenderClosedStatus}
+		require.NoError(t, handle(context.Background(), event))
+
+		require.Len(t, emails.appended, 2)
+		assert.Equal(t, "ru@example.com", emails.appended[0].To)
+		assert.Equal(t, "en@example.com", emails.appended[1].To)
+		assert.Equal(t, `Tender "Доставка" closed`, emails.appended[1].Subject)
+		assert.Contains(t, emails.appended[1].Body, "Hello, en!")
+	})
+}
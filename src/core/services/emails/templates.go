@@ -0,0 +1,86 @@
+package emails
+
+import (
+	"github.com/pkg/errors"
+	"strings"
+	"text/template"
+	"tms/src/core/domain"
+)
+
+// templateData Данные, подставляемые в шаблоны писем
+type templateData struct {
+	// Name имя получателя или его логин, если имя не указано
+	Name       string
+	TenderName domain.TenderName
+	BidName    domain.BidName
+	Decision   domain.DecisionStatus
+}
+
+type emailTemplate struct {
+	subject *template.Template
+	body    *template.Template
+}
+
+func mustTemplate(subject, body string) emailTemplate {
+	return emailTemplate{
+		subject: template.Must(template.New("subject").Parse(subject)),
+		body:    template.Must(template.New("body").Parse(body)),
+	}
+}
+
+var templates = map[domain.NotificationKind]map[domain.Language]emailTemplate{
+	domain.NotificationDecisionMadeKind: {
+		domain.LanguageRussian: mustTemplate(
+			`Решение по предложению «{{.BidName}}»`,
+			`Здравствуйте, {{.Name}}!
+
+По вашему предложению «{{.BidName}}» на тендер «{{.TenderName}}» принято решение: {{if eq .Decision "Approved"}}одобрено{{else}}отклонено{{end}}.
+
+Письмо отправлено автоматически. Отключить письма можно в настройках уведомлений.
+`),
+		domain.LanguageEnglish: mustTemplate(
+			`Decision on bid "{{.BidName}}"`,
+			`Hello, {{.Name}}!
+
+Your bid "{{.BidName}}" for tender "{{.TenderName}}" has been {{if eq .Decision "Approved"}}approved{{else}}rejected{{end}}.
+
+This email was sent automatically. You can turn emails off in your notification preferences.
+`),
+	},
+	domain.NotificationTenderClosedKind: {
+		domain.LanguageRussian: mustTemplate(
+			`Тендер «{{.TenderName}}» закрыт`,
+			`Здравствуйте, {{.Name}}!
+
+Тендер «{{.TenderName}}», на который вы подали предложение, закрыт.
+
+Письмо отправлено автоматически. Отключить письма можно в настройках уведомлений.
+`),
+		domain.LanguageEnglish: mustTemplate(
+			`Tender "{{.TenderName}}" closed`,
+			`Hello, {{.Name}}!
+
+Tender "{{.TenderName}}" you submitted a bid for has been closed.
+
+This email was sent automatically. You can turn emails off in your notification preferences.
+`),
+	},
+}
+
+// render возвращает тему и текст письма kind на языке language
+func render(kind domain.NotificationKind, language domain.Language, data templateData) (string, string, error) {
+	t, ok := templates[kind][language]
+	if !ok {
+		return "", "", errors.Errorf("no %s email template for language %s", kind, language)
+	}
+
+	var subject, body strings.Builder
+	if err := t.subject.Execute(&subject, data); err != nil {
+		return "", "", err
+	}
+	if err := t.body.Execute(&body, data); err != nil {
+		return "", "", err
+	}
+
+	return subject.String(), body.String(), nil
+}
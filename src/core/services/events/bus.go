@@ -0,0 +1,63 @@
+package events
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"tms/src/core/domain"
+)
+
+// Subscription Именованный подписчик. Relay доставляет событие каждому подписчику отдельно
+// и запоминает доставку по Name, поэтому имя должно быть уникальным и не меняться между запусками
+type Subscription struct {
+	Name    string
+	Handler Handler
+}
+
+// Bus Реестр подписчиков на доменные события, из которого их получает Relay
+type Bus struct {
+	mu       sync.RWMutex
+	handlers map[domain.EventName][]Subscription
+	all      []Subscription
+}
+
+func NewBus() *Bus {
+	return &Bus{
+		handlers: make(map[domain.EventName][]Subscription),
+	}
+}
+
+// Subscribe подписывает handler под именем subscriber на события с именем name
+func (b *Bus) Subscribe(subscriber string, name domain.EventName, handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers[name] = append(b.handlers[name], Subscription{Name: subscriber, Handler: handler})
+}
+
+// SubscribeAll подписывает handler под именем subscriber на все события
+func (b *Bus) SubscribeAll(subscriber string, handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.all = append(b.all, Subscription{Name: subscriber, Handler: handler})
+}
+
+// Subscriptions возвращает подписчиков на события с именем name в порядке подписки
+func (b *Bus) Subscriptions(name domain.EventName) []Subscription {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	result := make([]Subscription, 0, len(b.handlers[name])+len(b.all))
+	result = append(result, b.handlers[name]...)
+	return append(result, b.all...)
+}
+
+// LogHandler подписчик, записывающий события в лог
+func LogHandler(log *slog.Logger) Handler {
+	return func(_ context.Context, event domain.Event) error {
+		log.Info("domain event",
+			slog.String("event", string(event.EventName())),
+			slog.String("aggregateId", string(event.AggregateID())),
+			slog.String("eventId", string(event.Meta().EventID)))
+		return nil
+	}
+}
@@ -0,0 +1,14 @@
+package events
+
+import (
+	"context"
+	"tms/src/core/domain"
+)
+
+// Dispatcher доставляет доменные события, собранные сценариями после сохранения агрегатов
+type Dispatcher interface {
+	Dispatch(ctx context.Context, events ...domain.Event) error
+}
+
+// Handler подписчик на доменные события
+type Handler func(ctx context.Context, event domain.Event) error
@@ -0,0 +1,36 @@
+package events
+
+import (
+	"context"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+// OutboxDispatcher Dispatcher, записывающий события в outbox. Вызывается внутри транзакции сценария,
+// поэтому события сохраняются только вместе с изменением агрегата. Доставку выполняет Relay
+type OutboxDispatcher struct {
+	outboxRepository repositories.OutboxRepository
+}
+
+func NewOutboxDispatcher(outboxRepository repositories.OutboxRepository) OutboxDispatcher {
+	return OutboxDispatcher{
+		outboxRepository: outboxRepository,
+	}
+}
+
+func (d OutboxDispatcher) Dispatch(ctx context.Context, events ...domain.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	messages := make([]domain.OutboxMessage, 0, len(events))
+	for _, event := range events {
+		message, err := domain.NewOutboxMessage(event)
+		if err != nil {
+			return err
+		}
+		messages = append(messages, *message)
+	}
+
+	return d.outboxRepository.Append(ctx, messages...)
+}
@@ -0,0 +1,169 @@
+package events
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"log/slog"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+	"tms/src/pkg/logger/sl"
+)
+
+type RelayConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+	MaxAttempts  int
+	// Lease время, на которое выбранные сообщения скрываются от других экземпляров Relay
+	Lease time.Duration
+	// BaseBackoff задержка после первой неудачной попытки, далее удваивается до MaxBackoff
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+}
+
+// Subscriptions Источник подписчиков, которым Relay доставляет события, например Bus
+type Subscriptions interface {
+	Subscriptions(name domain.EventName) []Subscription
+}
+
+// Relay периодически выбирает недоставленные сообщения outbox и доставляет их события подписчикам
+type Relay struct {
+	transactor       repositories.Transactor
+	outboxRepository repositories.OutboxRepository
+	subscriptions    Subscriptions
+	cfg              RelayConfig
+	log              *slog.Logger
+}
+
+func NewRelay(
+	transactor repositories.Transactor,
+	outboxRepository repositories.OutboxRepository,
+	subscriptions Subscriptions,
+	cfg RelayConfig,
+	log *slog.Logger,
+) *Relay {
+	return &Relay{
+		transactor:       transactor,
+		outboxRepository: outboxRepository,
+		subscriptions:    subscriptions,
+		cfg:              cfg,
+		log:              log,
+	}
+}
+
+// Run доставляет сообщения до отмены ctx
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		// Полная пачка означает, что в outbox могут оставаться сообщения, поэтому следующая выбирается сразу
+		n, err := r.Process(ctx)
+		if err != nil && ctx.Err() == nil {
+			r.log.Error("outbox relay failed", sl.Err(err))
+		}
+		if err == nil && n == r.cfg.BatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Process доставляет одну пачку сообщений и возвращает их кол-во. Сообщения выбираются на время Lease
+// без удержания блокировок, поэтому несколько экземпляров приложения не доставляют их одновременно
+func (r *Relay) Process(ctx context.Context) (int, error) {
+	messages, err := r.outboxRepository.Claim(ctx, repositories.ClaimOutboxDTO{
+		Limit:       r.cfg.BatchSize,
+		MaxAttempts: r.cfg.MaxAttempts,
+		Lease:       r.cfg.Lease,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, m := range messages {
+		if err := r.finish(ctx, m, r.deliver(ctx, m)); err != nil {
+			if errors.Is(err, repositories.ErrLeaseLost) {
+				r.log.Warn("outbox message lease lost, result discarded",
+					slog.String("event", string(m.EventName)),
+					slog.String("eventId", string(m.ID)))
+				continue
+			}
+			return len(messages), err
+		}
+	}
+
+	return len(messages), nil
+}
+
+// finish записывает результат доставки m: при ошибке deliveryErr назначает следующую попытку
+// или переводит сообщение в dead-letter список после MaxAttempts попыток
+func (r *Relay) finish(ctx context.Context, m domain.OutboxMessage, deliveryErr error) error {
+	if deliveryErr == nil {
+		return r.outboxRepository.MarkDelivered(ctx, m)
+	}
+
+	log := r.log.With(
+		slog.String("event", string(m.EventName)),
+		slog.String("eventId", string(m.ID)),
+		slog.Int("attempt", m.Attempts+1),
+		sl.Err(deliveryErr))
+
+	if m.Attempts+1 >= r.cfg.MaxAttempts {
+		log.Error("outbox message moved to dead letters")
+		return r.outboxRepository.MarkDead(ctx, m, deliveryErr.Error())
+	}
+
+	log.Warn("outbox message delivery failed")
+	next := time.Now().Add(Backoff(m.Attempts+1, r.cfg.BaseBackoff, r.cfg.MaxBackoff))
+	return r.outboxRepository.MarkFailed(ctx, m, next, deliveryErr.Error())
+}
+
+// deliver передает событие сообщения подписчикам, которые его еще не получили. Каждый подписчик
+// выполняется в своей транзакции вместе с отметкой о доставке, поэтому при повторной попытке
+// вызываются только подписчики, завершившиеся ошибкой. Возвращает первую ошибку подписчика
+func (r *Relay) deliver(ctx context.Context, m domain.OutboxMessage) error {
+	event, err := m.Event()
+	if err != nil {
+		return err
+	}
+
+	var first error
+
+	for _, s := range r.subscriptions.Subscriptions(event.EventName()) {
+		err := r.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			marked, err := r.outboxRepository.MarkSubscriberDelivered(ctx, m.ID, s.Name)
+			if err != nil || !marked {
+				return err
+			}
+			return s.Handler(ctx, event)
+		})
+		if err != nil {
+			r.log.Error("event subscriber failed",
+				slog.String("event", string(m.EventName)),
+				slog.String("eventId", string(m.ID)),
+				slog.String("subscriber", s.Name),
+				sl.Err(err))
+			if first == nil {
+				first = err
+			}
+		}
+	}
+
+	return first
+}
+
+// Backoff возвращает задержку перед следующей попыткой после attempt неудачных: base, удвоенная на каждую попытку, но не больше max
+func Backoff(attempt int, base, max time.Duration) time.Duration {
+	delay := base
+	for i := 1; i < attempt && delay < max; i++ {
+		delay *= 2
+	}
+
+	return min(delay, max)
+}
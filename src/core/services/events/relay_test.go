@@ -0,0 +1,229 @@
+package events
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"log/slog"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+type fakeTransactor struct{}
+
+func (fakeTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type fakeOutbox struct {
+	pending    []domain.OutboxMessage
+	delivered  []domain.ID
+	failed     map[domain.ID]time.Time
+	dead       []domain.ID
+	subscribed map[string]bool
+	// claims кол-во выборок, каждая выдает сообщениям новый LockToken
+	claims int
+}
+
+func (f *fakeOutbox) Get(context.Context, domain.ID) (*domain.OutboxMessage, error) {
+	return &f.pending[0], nil
+}
+
+func (f *fakeOutbox) GetList(context.Context, repositories.GetOutboxListDTO) ([]domain.OutboxMessage, error) {
+	return f.pending, nil
+}
+
+func (f *fakeOutbox) LastStreamSeq(context.Context) (int64, error) { return 0, nil }
+
+func (f *fakeOutbox) AssignStreamSeq(context.Context, domain.ID) error { return nil }
+
+func (f *fakeOutbox) Append(_ context.Context, messages ...domain.OutboxMessage) error {
+	f.pending = append(f.pending, messages...)
+	return nil
+}
+
+func (f *fakeOutbox) Claim(_ context.Context, dto repositories.ClaimOutboxDTO) ([]domain.OutboxMessage, error) {
+	f.claims++
+	token := strconv.Itoa(f.claims)
+	for i := range f.pending {
+		f.pending[i].LockToken = &token
+	}
+	return slices.Clone(f.pending[:min(dto.Limit, len(f.pending))]), nil
+}
+
+// leased возвращает сохраненное сообщение m, если его аренда еще действует
+func (f *fakeOutbox) leased(m domain.OutboxMessage) (*domain.OutboxMessage, error) {
+	for i := range f.pending {
+		if f.pending[i].ID == m.ID && *f.pending[i].LockToken == *m.LockToken {
+			return &f.pending[i], nil
+		}
+	}
+	return nil, repositories.ErrLeaseLost
+}
+
+func (f *fakeOutbox) MarkSubscriberDelivered(_ context.Context, id domain.ID, subscriber string) (bool, error) {
+	key := string(id) + "/" + subscriber
+	if f.subscribed[key] {
+		return false, nil
+	}
+	f.subscribed[key] = true
+	return true, nil
+}
+
+func (f *fakeOutbox) MarkDelivered(_ context.Context, m domain.OutboxMessage) error {
+	if _, err := f.leased(m); err != nil {
+		return err
+	}
+	f.delivered = append(f.delivered, m.ID)
+	return nil
+}
+
+func (f *fakeOutbox) MarkFailed(_ context.Context, m domain.OutboxMessage, nextAttemptAt time.Time, _ string) error {
+	stored, err := f.leased(m)
+	if err != nil {
+		return err
+	}
+	stored.Attempts++
+	f.failed[m.ID] = nextAttemptAt
+	return nil
+}
+
+func (f *fakeOutbox) MarkDead(_ context.Context, m domain.OutboxMessage, _ string) error {
+	stored, err := f.leased(m)
+	if err != nil {
+		return err
+	}
+	stored.Attempts++
+	f.dead = append(f.dead, m.ID)
+	return nil
+}
+
+// rollbackTransactor откатывает отметку о доставке подписчику, если fn вернула ошибку
+type rollbackTransactor struct {
+	outbox *fakeOutbox
+}
+
+func (t rollbackTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	before := make(map[string]bool, len(t.outbox.subscribed))
+	for k, v := range t.outbox.subscribed {
+		before[k] = v
+	}
+
+	err := fn(ctx)
+	if err != nil {
+		t.outbox.subscribed = before
+	}
+	return err
+}
+
+func newTenderOutbox(t *testing.T) *fakeOutbox {
+	tender, err := domain.NewTender("Доставка", "Описание", string(domain.TenderDeliveryServiceType), "org", nil, nil, nil, domain.OrganizationResponsible{OrganizationID: "org"})
+	require.NoError(t, err)
+
+	outbox := &fakeOutbox{failed: map[domain.ID]time.Time{}, subscribed: map[string]bool{}}
+	require.NoError(t, NewOutboxDispatcher(outbox).Dispatch(context.Background(), tender.PullEvents()...))
+	require.Len(t, outbox.pending, 1)
+
+	return outbox
+}
+
+func TestRelay(t *testing.T) {
+	cfg := RelayConfig{BatchSize: 10, MaxAttempts: 3, BaseBackoff: time.Second, MaxBackoff: 5 * time.Second}
+
+	t.Run("delivers decoded events", func(t *testing.T) {
+		outbox := newTenderOutbox(t)
+
+		var got []domain.Event
+		bus := NewBus()
+		bus.SubscribeAll("test", func(_ context.Context, event domain.Event) error {
+			got = append(got, event)
+			return nil
+		})
+
+		relay := NewRelay(fakeTransactor{}, outbox, bus, cfg, slog.Default())
+		n, err := relay.Process(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.Equal(t, []domain.ID{outbox.pending[0].ID}, outbox.delivered)
+		require.Len(t, got, 1)
+		assert.Equal(t, domain.TenderCreatedEvent, got[0].EventName())
+		assert.Equal(t, outbox.pending[0].AggregateID, got[0].AggregateID())
+	})
+
+	t.Run("retries only failed subscribers", func(t *testing.T) {
+		outbox := newTenderOutbox(t)
+
+		calls := map[string]int{}
+		failing := true
+		bus := NewBus()
+		bus.SubscribeAll("ok", func(context.Context, domain.Event) error {
+			calls["ok"]++
+			return nil
+		})
+		bus.Subscribe("flaky", domain.TenderCreatedEvent, func(context.Context, domain.Event) error {
+			calls["flaky"]++
+			if failing {
+				return errors.New("unavailable")
+			}
+			return nil
+		})
+
+		relay := NewRelay(rollbackTransactor{outbox: outbox}, outbox, bus, cfg, slog.Default())
+
+		_, err := relay.Process(context.Background())
+		require.NoError(t, err)
+		assert.Empty(t, outbox.delivered)
+		assert.WithinDuration(t, time.Now().Add(time.Second), outbox.failed[outbox.pending[0].ID], 100*time.Millisecond)
+
+		failing = false
+		_, err = relay.Process(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, []domain.ID{outbox.pending[0].ID}, outbox.delivered)
+		assert.Equal(t, map[string]int{"ok": 1, "flaky": 2}, calls)
+	})
+
+	t.Run("moves exhausted message to dead letters", func(t *testing.T) {
+		outbox := newTenderOutbox(t)
+
+		bus := NewBus()
+		bus.SubscribeAll("broken", func(context.Context, domain.Event) error {
+			return errors.New("unavailable")
+		})
+
+		relay := NewRelay(rollbackTransactor{outbox: outbox}, outbox, bus, cfg, slog.Default())
+		for range cfg.MaxAttempts {
+			_, err := relay.Process(context.Background())
+			require.NoError(t, err)
+		}
+
+		assert.Equal(t, []domain.ID{outbox.pending[0].ID}, outbox.dead)
+		assert.Equal(t, cfg.MaxAttempts, outbox.pending[0].Attempts)
+	})
+
+	t.Run("discards result after lease is lost", func(t *testing.T) {
+		outbox := newTenderOutbox(t)
+
+		// Пока идет доставка, аренда истекает и сообщение выбирает другой экземпляр
+		bus := NewBus()
+		bus.SubscribeAll("slow", func(context.Context, domain.Event) error {
+			_, err := outbox.Claim(context.Background(), repositories.ClaimOutboxDTO{Limit: 10})
+			return err
+		})
+
+		relay := NewRelay(fakeTransactor{}, outbox, bus, cfg, slog.Default())
+		_, err := relay.Process(context.Background())
+		require.NoError(t, err)
+		assert.Empty(t, outbox.delivered)
+	})
+
+	t.Run("backoff", func(t *testing.T) {
+		assert.Equal(t, time.Second, Backoff(1, cfg.BaseBackoff, cfg.MaxBackoff))
+		assert.Equal(t, 4*time.Second, Backoff(3, cfg.BaseBackoff, cfg.MaxBackoff))
+		assert.Equal(t, 5*time.Second, Backoff(10, cfg.BaseBackoff, cfg.MaxBackoff))
+	})
+}
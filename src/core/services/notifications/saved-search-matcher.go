@@ -0,0 +1,53 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"tms/src/core/domain"
+	"tms/src/core/services/events"
+	"tms/src/core/services/repositories"
+)
+
+// SavedSearchMatcher подписчик событий, уведомляющий сотрудников о публикации тендера,
+// подходящего под их сохраненные поиски. Сотрудник получает одно уведомление, даже если тендер подходит под несколько поисков
+func SavedSearchMatcher(
+	notificationRepository repositories.NotificationRepository,
+	savedSearchRepository repositories.SavedSearchRepository,
+	tenderRepository repositories.TenderRepository,
+) events.Handler {
+	return func(ctx context.Context, event domain.Event) error {
+		e, ok := event.(domain.TenderStatusChanged)
+		if !ok || e.To != domain.TenderPublishedStatus {
+			return nil
+		}
+
+		searches, err := savedSearchRepository.GetMatching(ctx, e.TenderID)
+		if err != nil {
+			return err
+		}
+		if len(searches) == 0 {
+			return nil
+		}
+
+		tender, err := tenderRepository.Get(ctx, repositories.GetTenderDTO{ID: e.TenderID})
+		if err != nil {
+			return err
+		}
+
+		notifications := make([]domain.Notification, 0, len(searches))
+		notified := make(map[domain.ID]struct{}, len(searches))
+
+		for _, s := range searches {
+			if _, ok := notified[s.EmployeeID]; ok {
+				continue
+			}
+			notified[s.EmployeeID] = struct{}{}
+
+			message := fmt.Sprintf("Tender '%s' matching saved search '%s' published", tender.Name, s.Name)
+			notifications = append(notifications,
+				domain.NewNotification(s.EmployeeID, domain.NotificationSavedSearchMatchedKind, e, nil, message))
+		}
+
+		return notificationRepository.Append(ctx, notifications...)
+	}
+}
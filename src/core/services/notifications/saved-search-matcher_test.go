@@ -0,0 +1,52 @@
+package notifications
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+type fakeSavedSearches struct {
+	repositories.SavedSearchRepository
+	matching []domain.SavedSearch
+}
+
+func (f fakeSavedSearches) GetMatching(context.Context, domain.ID) ([]domain.SavedSearch, error) {
+	return f.matching, nil
+}
+
+func TestSavedSearchMatcher(t *testing.T) {
+	executor := domain.OrganizationResponsible{OrganizationID: "org", UserID: "responsible"}
+
+	tender, err := domain.NewTender("Доставка", "Описание", string(domain.TenderDeliveryServiceType), "org", nil, nil, nil, executor)
+	require.NoError(t, err)
+	require.NoError(t, tender.ChangeStatus(executor, string(domain.TenderPublishedStatus), nil))
+	events := tender.PullEvents()
+
+	byType, err := domain.NewSavedSearch("supplier", "Доставка", []string{string(domain.TenderDeliveryServiceType)}, nil, nil)
+	require.NoError(t, err)
+	byOrganization, err := domain.NewSavedSearch("supplier", "Организация", nil, []string{"org"}, nil)
+	require.NoError(t, err)
+	other, err := domain.NewSavedSearch("other", "Организация", nil, []string{"org"}, nil)
+	require.NoError(t, err)
+
+	notifications := &fakeNotifications{}
+	handle := SavedSearchMatcher(
+		notifications,
+		fakeSavedSearches{matching: []domain.SavedSearch{*byType, *byOrganization, *other}},
+		fakeTenders{tender: *tender},
+	)
+
+	// Создание тендера не является публикацией
+	require.NoError(t, handle(context.Background(), events[0]))
+	assert.Empty(t, notifications.appended)
+
+	require.NoError(t, handle(context.Background(), events[1]))
+	require.Len(t, notifications.appended, 2)
+	assert.Equal(t, domain.ID("supplier"), notifications.appended[0].RecipientID)
+	assert.Equal(t, domain.NotificationSavedSearchMatchedKind, notifications.appended[0].Kind)
+	assert.Equal(t, domain.ID("other"), notifications.appended[1].RecipientID)
+}
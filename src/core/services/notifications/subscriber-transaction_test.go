@@ -0,0 +1,21 @@
+package notifications
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"tms/src/core/data/fixtures"
+	"tms/src/core/domain"
+)
+
+// Подписчики вызываются в транзакции relay, где все запросы идут через одно соединение
+func TestSubscriber_TenderClosedWithinTransaction(t *testing.T) {
+	fixtures.ClosedTender(t, []domain.ID{"first", "second"}, func(ctx context.Context, r fixtures.Repositories, event domain.TenderStatusChanged) {
+		p := producer{tenderRepository: r.Tenders, bidRepository: r.Bids}
+
+		notifications, err := p.notifications(ctx, event)
+		require.NoError(t, err)
+		assert.Len(t, notifications, 2)
+	})
+}
@@ -0,0 +1,140 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"tms/src/core/domain"
+	"tms/src/core/services/events"
+	"tms/src/core/services/repositories"
+)
+
+// Subscriber подписчик событий, создающий уведомления сотрудникам:
+//   - автору предложения - о решении по нему и об изменении его статуса другим сотрудником;
+//   - ответственным за организацию тендера - о публикации предложения на тендер;
+//   - авторам предложений - о закрытии тендера.
+//
+// Об отзывах на предложения уведомления не создаются: отзывов в сервисе нет, эндпоинты
+// /bids/{bidId}/feedback и /bids/{tenderId}/reviews из задания не реализованы.
+//
+// Повторный вызов для того же события не создает новых уведомлений
+func Subscriber(
+	notificationRepository repositories.NotificationRepository,
+	tenderRepository repositories.TenderRepository,
+	bidRepository repositories.BidRepository,
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository,
+) events.Handler {
+	p := producer{
+		tenderRepository:         tenderRepository,
+		bidRepository:            bidRepository,
+		orgResponsibleRepository: orgResponsibleRepository,
+	}
+
+	return func(ctx context.Context, event domain.Event) error {
+		notifications, err := p.notifications(ctx, event)
+		if err != nil {
+			return err
+		}
+
+		return notificationRepository.Append(ctx, notifications...)
+	}
+}
+
+type producer struct {
+	tenderRepository         repositories.TenderRepository
+	bidRepository            repositories.BidRepository
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository
+}
+
+func (p producer) notifications(ctx context.Context, event domain.Event) ([]domain.Notification, error) {
+	switch e := event.(type) {
+	case domain.DecisionMade:
+		return p.decisionMade(ctx, e)
+	case domain.BidStatusChanged:
+		return p.bidStatusChanged(ctx, e)
+	case domain.TenderStatusChanged:
+		if e.To == domain.TenderClosedStatus {
+			return p.tenderClosed(ctx, e)
+		}
+	}
+
+	return nil, nil
+}
+
+func (p producer) decisionMade(ctx context.Context, e domain.DecisionMade) ([]domain.Notification, error) {
+	bid, err := p.bidRepository.Get(ctx, repositories.GetBidDTO{ID: e.BidID})
+	if err != nil {
+		return nil, err
+	}
+
+	message := fmt.Sprintf("Decision on bid '%s': %s", bid.Name, e.Status)
+
+	return []domain.Notification{
+		domain.NewNotification(bid.AuthorID, domain.NotificationDecisionMadeKind, e, &bid.ID, message),
+	}, nil
+}
+
+func (p producer) bidStatusChanged(ctx context.Context, e domain.BidStatusChanged) ([]domain.Notification, error) {
+	bid, err := p.bidRepository.Get(ctx, repositories.GetBidDTO{ID: e.BidID})
+	if err != nil {
+		return nil, err
+	}
+
+	notifications := make([]domain.Notification, 0)
+
+	if e.EditorID != bid.AuthorID {
+		message := fmt.Sprintf("Bid '%s' status changed from %s to %s", bid.Name, e.From, e.To)
+		notifications = append(notifications,
+			domain.NewNotification(bid.AuthorID, domain.NotificationBidStatusChangedKind, e, &bid.ID, message))
+	}
+
+	if e.To != domain.BidPublishedStatus {
+		return notifications, nil
+	}
+
+	tender, err := p.tenderRepository.Get(ctx, repositories.GetTenderDTO{ID: e.TenderID})
+	if err != nil {
+		return nil, err
+	}
+
+	responsibles, err := p.orgResponsibleRepository.GetList(ctx, repositories.GetOrganizationResponsiblesListDTO{
+		OrganizationID: &tender.OrganizationID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	message := fmt.Sprintf("Bid '%s' published on tender '%s'", bid.Name, tender.Name)
+	for _, r := range responsibles {
+		notifications = append(notifications,
+			domain.NewNotification(r.UserID, domain.NotificationBidPublishedKind, e, &bid.ID, message))
+	}
+
+	return notifications, nil
+}
+
+func (p producer) tenderClosed(ctx context.Context, e domain.TenderStatusChanged) ([]domain.Notification, error) {
+	tender, err := p.tenderRepository.Get(ctx, repositories.GetTenderDTO{ID: e.TenderID})
+	if err != nil {
+		return nil, err
+	}
+
+	bids, err := p.bidRepository.GetList(ctx, repositories.GetBidListDTO{TenderID: &tender.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	message := fmt.Sprintf("Tender '%s' closed", tender.Name)
+	notifications := make([]domain.Notification, 0, len(bids))
+	notified := make(map[domain.ID]struct{}, len(bids))
+
+	for _, bid := range bids {
+		if _, ok := notified[bid.AuthorID]; ok {
+			continue
+		}
+		notified[bid.AuthorID] = struct{}{}
+		notifications = append(notifications,
+			domain.NewNotification(bid.AuthorID, domain.NotificationTenderClosedKind, e, nil, message))
+	}
+
+	return notifications, nil
+}
@@ -0,0 +1,124 @@
+package notifications
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+type fakeNotifications struct {
+	repositories.NotificationRepository
+	appended []domain.Notification
+}
+
+func (f *fakeNotifications) Append(_ context.Context, notifications ...domain.Notification) error {
+	f.appended = append(f.appended, notifications...)
+	return nil
+}
+
+type fakeTenders struct {
+	repositories.TenderRepository
+	tender domain.Tender
+}
+
+func (f fakeTenders) Get(context.Context, repositories.GetTenderDTO) (*domain.Tender, error) {
+	return &f.tender, nil
+}
+
+type fakeBids struct {
+	repositories.BidRepository
+	bids []domain.Bid
+}
+
+func (f fakeBids) Get(_ context.Context, dto repositories.GetBidDTO) (*domain.Bid, error) {
+	for _, b := range f.bids {
+		if b.ID == dto.ID {
+			return &b, nil
+		}
+	}
+	return nil, domain.ErrNotFound
+}
+
+func (f fakeBids) GetList(context.Context, repositories.GetBidListDTO) ([]domain.Bid, error) {
+	return f.bids, nil
+}
+
+type fakeResponsibles struct {
+	repositories.OrganizationResponsibleRepository
+	responsibles []domain.OrganizationResponsible
+}
+
+func (f fakeResponsibles) GetList(context.Context, repositories.GetOrganizationResponsiblesListDTO) ([]domain.OrganizationResponsible, error) {
+	return f.responsibles, nil
+}
+
+func TestSubscriber(t *testing.T) {
+	executor := domain.OrganizationResponsible{OrganizationID: "org", UserID: "responsible"}
+
+	tender, err := domain.NewTender("Доставка", "Описание", string(domain.TenderDeliveryServiceType), "org", nil, nil, nil, executor)
+	require.NoError(t, err)
+
+	price := domain.Money{Amount: 100000, Currency: domain.RUBCurrency}
+	first, err := domain.NewBid("Первое", "Описание", string(domain.BidAuthorUserType), price, tender.ID, "author")
+	require.NoError(t, err)
+	second, err := domain.NewBid("Второе", "Описание", string(domain.BidAuthorUserType), price, tender.ID, "author")
+	require.NoError(t, err)
+
+	notifications := &fakeNotifications{}
+	handle := Subscriber(
+		notifications,
+		fakeTenders{tender: *tender},
+		fakeBids{bids: []domain.Bid{*first, *second}},
+		fakeResponsibles{responsibles: []domain.OrganizationResponsible{executor}},
+	)
+
+	tests := []struct {
+		name  string
+		event domain.Event
+		want  map[domain.ID]domain.NotificationKind
+	}{
+		{
+			name:  "decision notifies bid author",
+			event: domain.DecisionMade{BidID: first.ID, TenderID: tender.ID, AuthorID: "responsible", Status: domain.DecisionApprovedStatus},
+			want:  map[domain.ID]domain.NotificationKind{"author": domain.NotificationDecisionMadeKind},
+		},
+		{
+			name: "own publication notifies tender responsibles only",
+			event: domain.BidStatusChanged{BidID: first.ID, TenderID: tender.ID, EditorID: "author",
+				From: domain.BidCreatedStatus, To: domain.BidPublishedStatus},
+			want: map[domain.ID]domain.NotificationKind{"responsible": domain.NotificationBidPublishedKind},
+		},
+		{
+			name: "tender closing notifies each bid author once",
+			event: domain.TenderStatusChanged{TenderID: tender.ID, OrganizationID: "org", EditorID: "responsible",
+				From: domain.TenderPublishedStatus, To: domain.TenderClosedStatus},
+			want: map[domain.ID]domain.NotificationKind{"author": domain.NotificationTenderClosedKind},
+		},
+		{
+			name:  "other events are ignored",
+			event: domain.TenderEdited{TenderID: tender.ID, OrganizationID: "org"},
+			want:  map[domain.ID]domain.NotificationKind{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notifications.appended = nil
+
+			require.NoError(t, handle(context.Background(), tt.event))
+
+			got := make(map[domain.ID]domain.NotificationKind)
+			for _, n := range notifications.appended {
+				assert.Equal(t, tender.ID, n.TenderID)
+				assert.WithinDuration(t, time.Now(), n.CreatedAt, time.Second)
+				got[n.RecipientID] = n.Kind
+			}
+			assert.Len(t, notifications.appended, len(tt.want))
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package repositories
+
+import (
+	"context"
+	"time"
+	"tms/src/core/domain"
+)
+
+type GetAuditListDTO struct {
+	OrganizationID domain.ID
+	EntityType     *domain.AuditEntityType
+	EntityID       *domain.ID
+	Action         *domain.AuditAction
+	ActorID        *domain.ID
+	// CreatedFrom и CreatedTo включительная и исключающая границы времени записи
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	// After курсор keyset пагинации, записи возвращаются от новых к старым
+	After  *Cursor
+	Offset *Offset
+	Limit  *Limit
+}
+
+// AuditSort Порядок записей журнала аудита, других сортировок журнал не поддерживает
+var AuditSort = Sort{Field: SortByCreatedAt, Direction: SortDesc}
+
+// AuditCursor создает курсор, указывающий на entry
+func AuditCursor(entry domain.AuditEntry) Cursor {
+	return Cursor{Sort: AuditSort, Value: entry.CreatedAt.Format(time.RFC3339Nano), ID: entry.ID}
+}
+
+type AuditRepository interface {
+	GetList(ctx context.Context, dto GetAuditListDTO) ([]domain.AuditEntry, error)
+	Save(ctx context.Context, entry domain.AuditEntry) error
+}
@@ -7,12 +7,23 @@ import (
 
 type GetBidListDTO struct {
 	ID         *domain.ID
-	Status     *domain.BidStatus
+	IDs        []domain.ID
+	Statuses   []domain.BidStatus
 	TenderID   *domain.ID
 	AuthorType *domain.BidAuthorType
 	AuthorID   *domain.ID
-	Limit      *Limit
-	Offset     *Offset
+	Price      AmountFilter
+	// Фильтры по тендеру, к которому относится предложение
+	ServiceTypes    []domain.TenderServiceType
+	OrganizationIDs []domain.ID
+	TimeFilter
+	// Query полнотекстовый поисковый запрос
+	Query *string
+	Sort  *Sort
+	// After курсор keyset пагинации, возвращаются элементы строго после него
+	After  *Cursor
+	Limit  *Limit
+	Offset *Offset
 }
 
 type GetBidDTO struct {
@@ -22,6 +33,18 @@ type GetBidDTO struct {
 
 type BidRepository interface {
 	GetList(ctx context.Context, dto GetBidListDTO) ([]domain.Bid, error)
+	// Search то же, что и GetList, но дополнительно возвращает релевантность и фрагменты с совпадениями dto.Query
+	Search(ctx context.Context, dto GetBidListDTO) ([]BidSearchResult, error)
+	// Count возвращает кол-во предложений, подходящих под фильтры dto, без учета пагинации
+	Count(ctx context.Context, dto GetBidListDTO) (int, error)
 	Get(ctx context.Context, dto GetBidDTO) (*domain.Bid, error)
+	// GetNormalizedPrices возвращает цены предложений в валюте бюджета их тендеров по курсу на дату создания
+	// предложения. Цены тендеров без бюджета не переводятся, предложения без курса в результат не попадают
+	GetNormalizedPrices(ctx context.Context, ids []domain.ID) (map[domain.ID]domain.Money, error)
+	// GetWeightedScores возвращает взвешенные оценки предложений по текущим критериям их тендеров,
+	// оценка совпадает со значением сортировки SortByScore
+	GetWeightedScores(ctx context.Context, ids []domain.ID) (map[domain.ID]float64, error)
+	// GetStatusHistory возвращает журнал изменений статуса предложения по возрастанию времени
+	GetStatusHistory(ctx context.Context, bidID domain.ID) ([]domain.BidStatusChange, error)
 	Save(ctx context.Context, bid domain.Bid) error
 }
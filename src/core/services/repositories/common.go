@@ -1,5 +1,17 @@
 package repositories
 
+import (
+	"encoding/base64"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"strconv"
+	"time"
+	"tms/src/core/domain"
+)
+
+// ErrLeaseLost аренда выбранной записи очереди истекла, и ее уже выбрал другой экземпляр, поэтому результат не записан
+var ErrLeaseLost = errors.New("lease lost")
+
 // Limit Максимальное кол-во возвращаемых объектов
 type Limit uint
 
@@ -25,3 +37,362 @@ func NewOffset(n *int) Offset {
 	}
 	return Offset(*n)
 }
+
+// SortField Поле, по которому сортируется список
+type SortField string
+
+const (
+	SortByName      SortField = "name"
+	SortByCreatedAt SortField = "createdAt"
+	SortByVersion   SortField = "version"
+	// SortByRelevance релевантность полнотекстового поиска, доступна только вместе с поисковым запросом
+	SortByRelevance SortField = "relevance"
+	// SortByBudget бюджет тендера, тендеры без бюджета идут как тендеры с нулевым бюджетом
+	SortByBudget SortField = "budget"
+	// SortByPrice цена предложения
+	SortByPrice SortField = "price"
+	// SortByNormalizedPrice цена предложения в валюте бюджета тендера по курсу на дату создания предложения,
+	// предложения без курса идут как предложения с нулевой ценой
+	SortByNormalizedPrice SortField = "normalizedPrice"
+	// SortByScore взвешенная оценка предложения по критериям тендера, неоцененные критерии дают 0
+	SortByScore SortField = "score"
+)
+
+func NewSortField(str *string) (SortField, error) {
+	if str == nil {
+		return SortByName, nil
+	}
+	switch *str {
+	case string(SortByName), string(SortByCreatedAt), string(SortByVersion), string(SortByRelevance),
+		string(SortByBudget), string(SortByPrice), string(SortByNormalizedPrice), string(SortByScore):
+		return SortField(*str), nil
+	default:
+		return "", errors.Wrapf(domain.ErrValidation, "invalid sort field: %s", *str)
+	}
+}
+
+// SortDirection Направление сортировки
+type SortDirection string
+
+const (
+	SortAsc  SortDirection = "asc"
+	SortDesc SortDirection = "desc"
+)
+
+func NewSortDirection(str *string) (SortDirection, error) {
+	if str == nil {
+		return SortAsc, nil
+	}
+	switch *str {
+	case string(SortAsc), string(SortDesc):
+		return SortDirection(*str), nil
+	default:
+		return "", errors.Wrapf(domain.ErrValidation, "invalid sort direction: %s", *str)
+	}
+}
+
+// Sort Порядок элементов списка
+type Sort struct {
+	Field     SortField
+	Direction SortDirection
+}
+
+// NewSort создает Sort, по умолчанию список сортируется по названию по возрастанию,
+// а при поиске (search) - по убыванию релевантности. Оценка по умолчанию сортируется по убыванию
+func NewSort(field, direction *string, search bool) (Sort, error) {
+	desc := string(SortDesc)
+	if search && field == nil {
+		relevance := string(SortByRelevance)
+		field = &relevance
+		if direction == nil {
+			direction = &desc
+		}
+	}
+	if field != nil && *field == string(SortByScore) && direction == nil {
+		direction = &desc
+	}
+
+	f, err := NewSortField(field)
+	if err != nil {
+		return Sort{}, err
+	}
+
+	if f == SortByRelevance && !search {
+		return Sort{}, errors.Wrap(domain.ErrValidation, "sort by relevance requires a search query")
+	}
+
+	d, err := NewSortDirection(direction)
+	if err != nil {
+		return Sort{}, err
+	}
+
+	return Sort{
+		Field:     f,
+		Direction: d,
+	}, nil
+}
+
+// NewTenderSort то же, что и NewSort, но запрещает сортировку по полям предложений
+func NewTenderSort(field, direction *string, search bool) (Sort, error) {
+	sort, err := NewSort(field, direction, search)
+	if err == nil && (sort.Field == SortByPrice || sort.Field == SortByNormalizedPrice) {
+		return Sort{}, errors.Wrap(domain.ErrValidation, "tenders cannot be sorted by price")
+	}
+	if err == nil && sort.Field == SortByScore {
+		return Sort{}, errors.Wrap(domain.ErrValidation, "tenders cannot be sorted by score")
+	}
+	return sort, err
+}
+
+// NewBidSort то же, что и NewSort, но запрещает сортировку по полям тендеров
+func NewBidSort(field, direction *string, search bool) (Sort, error) {
+	sort, err := NewSort(field, direction, search)
+	if err == nil && sort.Field == SortByBudget {
+		return Sort{}, errors.Wrap(domain.ErrValidation, "bids cannot be sorted by budget")
+	}
+	return sort, err
+}
+
+// AmountFilter Фильтр списка по денежной сумме
+type AmountFilter struct {
+	Currency *domain.Currency
+	// Min и Max включительные границы суммы в минимальных единицах валюты
+	Min *int64
+	Max *int64
+}
+
+// NewAmountFilter разбирает фильтр по сумме. Границы задаются десятичными строками
+// и сравниваются только с суммами в той же валюте, поэтому требуют указания валюты
+func NewAmountFilter(currency, minAmount, maxAmount *string) (AmountFilter, error) {
+	var f AmountFilter
+
+	if currency != nil {
+		c, err := domain.NewCurrency(*currency)
+		if err != nil {
+			return AmountFilter{}, err
+		}
+		f.Currency = &c
+	}
+
+	if minAmount != nil {
+		amount, err := domain.ParseAmount(*minAmount)
+		if err != nil {
+			return AmountFilter{}, err
+		}
+		f.Min = &amount
+	}
+
+	if maxAmount != nil {
+		amount, err := domain.ParseAmount(*maxAmount)
+		if err != nil {
+			return AmountFilter{}, err
+		}
+		f.Max = &amount
+	}
+
+	if (f.Min != nil || f.Max != nil) && f.Currency == nil {
+		return AmountFilter{}, errors.Wrap(domain.ErrValidation, "amount range requires currency")
+	}
+
+	if f.Min != nil && f.Max != nil && *f.Min > *f.Max {
+		return AmountFilter{}, errors.Wrap(domain.ErrValidation, "minimum amount cannot exceed maximum amount")
+	}
+
+	return f, nil
+}
+
+// TimeFilter Фильтры списка по времени создания и последнего изменения
+type TimeFilter struct {
+	// CreatedFrom включительная нижняя граница created_at
+	CreatedFrom *time.Time
+	// CreatedTo исключающая верхняя граница created_at
+	CreatedTo *time.Time
+	// UpdatedSince включительная нижняя граница updated_at, используется для инкрементальной синхронизации
+	UpdatedSince *time.Time
+}
+
+// NewTimeFilter разбирает границы фильтров в формате RFC3339, отсутствующая граница не применяется
+func NewTimeFilter(createdFrom, createdTo, updatedSince *string) (TimeFilter, error) {
+	var (
+		f   TimeFilter
+		err error
+	)
+
+	if f.CreatedFrom, err = parseTime("created_from", createdFrom); err != nil {
+		return TimeFilter{}, err
+	}
+	if f.CreatedTo, err = parseTime("created_to", createdTo); err != nil {
+		return TimeFilter{}, err
+	}
+	if f.UpdatedSince, err = parseTime("updated_since", updatedSince); err != nil {
+		return TimeFilter{}, err
+	}
+
+	if f.CreatedFrom != nil && f.CreatedTo != nil && !f.CreatedFrom.Before(*f.CreatedTo) {
+		return TimeFilter{}, errors.Wrap(domain.ErrValidation, "created_from must be before created_to")
+	}
+
+	return f, nil
+}
+
+func parseTime(name string, str *string) (*time.Time, error) {
+	if str == nil {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, *str)
+	if err != nil {
+		return nil, errors.Wrapf(domain.ErrValidation, "%s must be in RFC3339 format", name)
+	}
+
+	return &t, nil
+}
+
+// Cursor Позиция в отсортированном списке, после которой начинается следующая страница
+type Cursor struct {
+	Sort  Sort
+	Value string
+	ID    domain.ID
+}
+
+type cursorToken struct {
+	Field     SortField     `json:"f"`
+	Direction SortDirection `json:"d"`
+	Value     string        `json:"v"`
+	ID        domain.ID     `json:"id"`
+}
+
+// NewCursor разбирает непрозрачный токен курсора, полученный клиентом в предыдущем ответе
+func NewCursor(token *string) (*Cursor, error) {
+	if token == nil {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(*token)
+	if err != nil {
+		return nil, errors.Wrap(domain.ErrValidation, "invalid cursor")
+	}
+
+	var t cursorToken
+	if err := json.Unmarshal(raw, &t); err != nil {
+		return nil, errors.Wrap(domain.ErrValidation, "invalid cursor")
+	}
+
+	field, err := NewSortField((*string)(&t.Field))
+	if err != nil {
+		return nil, errors.Wrap(domain.ErrValidation, "invalid cursor")
+	}
+
+	direction, err := NewSortDirection((*string)(&t.Direction))
+	if err != nil {
+		return nil, errors.Wrap(domain.ErrValidation, "invalid cursor")
+	}
+
+	c := &Cursor{
+		Sort:  Sort{Field: field, Direction: direction},
+		Value: t.Value,
+		ID:    t.ID,
+	}
+
+	if _, err := c.SortValue(); err != nil {
+		return nil, errors.Wrap(domain.ErrValidation, "invalid cursor")
+	}
+
+	return c, nil
+}
+
+// Token кодирует курсор в непрозрачную строку для клиента
+func (c Cursor) Token() string {
+	raw, _ := json.Marshal(cursorToken{
+		Field:     c.Sort.Field,
+		Direction: c.Sort.Direction,
+		Value:     c.Value,
+		ID:        c.ID,
+	})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// SortValue значение поля сортировки в типе соответствующей колонки
+func (c Cursor) SortValue() (interface{}, error) {
+	switch c.Sort.Field {
+	case SortByCreatedAt:
+		return time.Parse(time.RFC3339Nano, c.Value)
+	case SortByVersion:
+		return strconv.Atoi(c.Value)
+	case SortByBudget, SortByPrice, SortByNormalizedPrice:
+		return strconv.ParseInt(c.Value, 10, 64)
+	case SortByRelevance:
+		rank, err := strconv.ParseFloat(c.Value, 32)
+		return float32(rank), err
+	case SortByScore:
+		return strconv.ParseFloat(c.Value, 64)
+	default:
+		return c.Value, nil
+	}
+}
+
+// TenderCursor создает курсор, указывающий на tender
+func TenderCursor(sort Sort, tender domain.Tender) Cursor {
+	value := string(tender.Name)
+	switch sort.Field {
+	case SortByCreatedAt:
+		value = tender.CreatedAt.Format(time.RFC3339Nano)
+	case SortByVersion:
+		value = strconv.Itoa(int(tender.Version))
+	case SortByBudget:
+		value = "0"
+		if tender.Budget != nil {
+			value = strconv.FormatInt(tender.Budget.Amount, 10)
+		}
+	}
+
+	return Cursor{Sort: sort, Value: value, ID: tender.ID}
+}
+
+// BidCursor создает курсор, указывающий на bid
+func BidCursor(sort Sort, bid domain.Bid) Cursor {
+	value := string(bid.Name)
+	switch sort.Field {
+	case SortByCreatedAt:
+		value = bid.CreatedAt.Format(time.RFC3339Nano)
+	case SortByVersion:
+		value = strconv.Itoa(int(bid.Version))
+	case SortByPrice:
+		value = strconv.FormatInt(bid.Price.Amount, 10)
+	}
+
+	return Cursor{Sort: sort, Value: value, ID: bid.ID}
+}
+
+// Page Страница списка
+type Page[T any] struct {
+	Items []T
+	// NextCursor курсор следующей страницы, nil если страница последняя
+	NextCursor *Cursor
+	// Total общее кол-во элементов, подходящих под фильтры, если его запросили
+	Total *int
+}
+
+// NextToken токен курсора следующей страницы, пустая строка если страница последняя
+func (p Page[T]) NextToken() string {
+	if p.NextCursor == nil {
+		return ""
+	}
+	return p.NextCursor.Token()
+}
+
+// NewPage собирает страницу из выборки, запрошенной с limit+1 элементами:
+// лишний элемент означает, что за страницей есть продолжение
+func NewPage[T any](items []T, limit Limit, cursor func(T) Cursor) Page[T] {
+	page := Page[T]{Items: items}
+
+	if uint(len(items)) > uint(limit) {
+		page.Items = items[:limit]
+		if limit > 0 {
+			next := cursor(page.Items[limit-1])
+			page.NextCursor = &next
+		}
+	}
+
+	return page
+}
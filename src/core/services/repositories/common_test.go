@@ -0,0 +1,133 @@
+package repositories
+
+import (
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+	"tms/src/core/domain"
+)
+
+func TestCursor(t *testing.T) {
+	t.Run("token round trip", func(t *testing.T) {
+		createdAt := time.Date(2024, 9, 1, 12, 30, 0, 123000, time.UTC)
+		sort := Sort{Field: SortByCreatedAt, Direction: SortDesc}
+		cursor := TenderCursor(sort, domain.Tender{ID: "tender-1", CreatedAt: createdAt})
+
+		token := cursor.Token()
+		decoded, err := NewCursor(&token)
+		require.NoError(t, err)
+		assert.Equal(t, cursor, *decoded)
+
+		value, err := decoded.SortValue()
+		require.NoError(t, err)
+		assert.True(t, createdAt.Equal(value.(time.Time)))
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		token := "not-a-cursor"
+		_, err := NewCursor(&token)
+		assert.True(t, errors.Is(errors.Cause(err), domain.ErrValidation))
+	})
+
+	t.Run("no token", func(t *testing.T) {
+		cursor, err := NewCursor(nil)
+		assert.NoError(t, err)
+		assert.Nil(t, cursor)
+	})
+}
+
+func TestNewPage(t *testing.T) {
+	sort := Sort{Field: SortByName, Direction: SortAsc}
+	cursor := func(b domain.Bid) Cursor { return BidCursor(sort, b) }
+	bids := []domain.Bid{{ID: "1", Name: "a"}, {ID: "2", Name: "b"}, {ID: "3", Name: "c"}}
+
+	t.Run("more items than limit", func(t *testing.T) {
+		page := NewPage(bids, 2, cursor)
+		assert.Len(t, page.Items, 2)
+		require.NotNil(t, page.NextCursor)
+		assert.Equal(t, domain.ID("2"), page.NextCursor.ID)
+		assert.Equal(t, "b", page.NextCursor.Value)
+	})
+
+	t.Run("last page", func(t *testing.T) {
+		page := NewPage(bids, 3, cursor)
+		assert.Len(t, page.Items, 3)
+		assert.Nil(t, page.NextCursor)
+		assert.Empty(t, page.NextToken())
+	})
+}
+
+func TestNewSort(t *testing.T) {
+	t.Run("search defaults to relevance", func(t *testing.T) {
+		sort, err := NewSort(nil, nil, true)
+		require.NoError(t, err)
+		assert.Equal(t, Sort{Field: SortByRelevance, Direction: SortDesc}, sort)
+	})
+
+	t.Run("relevance without search", func(t *testing.T) {
+		field := string(SortByRelevance)
+		_, err := NewSort(&field, nil, false)
+		assert.True(t, errors.Is(errors.Cause(err), domain.ErrValidation))
+	})
+
+	t.Run("relevance cursor round trip", func(t *testing.T) {
+		sort := Sort{Field: SortByRelevance, Direction: SortDesc}
+		result := TenderSearchResult{Tender: domain.Tender{ID: "tender-1"}, Search: &SearchMatch{Rank: 0.0759909}}
+
+		token := result.Cursor(sort).Token()
+		decoded, err := NewCursor(&token)
+		require.NoError(t, err)
+
+		value, err := decoded.SortValue()
+		require.NoError(t, err)
+		assert.Equal(t, float32(0.0759909), value)
+	})
+
+	t.Run("score defaults to descending", func(t *testing.T) {
+		field := string(SortByScore)
+		sort, err := NewBidSort(&field, nil, false)
+		require.NoError(t, err)
+		assert.Equal(t, Sort{Field: SortByScore, Direction: SortDesc}, sort)
+
+		_, err = NewTenderSort(&field, nil, false)
+		assert.True(t, errors.Is(errors.Cause(err), domain.ErrValidation))
+	})
+
+	t.Run("score cursor round trip", func(t *testing.T) {
+		sort := Sort{Field: SortByScore, Direction: SortDesc}
+		score := 7.65
+		result := BidSearchResult{Bid: domain.Bid{ID: "bid-1"}, Score: &score}
+
+		token := result.Cursor(sort).Token()
+		decoded, err := NewCursor(&token)
+		require.NoError(t, err)
+
+		value, err := decoded.SortValue()
+		require.NoError(t, err)
+		assert.Equal(t, 7.65, value)
+	})
+}
+
+func TestNewTimeFilter(t *testing.T) {
+	from, to := "2024-09-01T00:00:00+03:00", "2024-10-01T00:00:00Z"
+
+	t.Run("valid range", func(t *testing.T) {
+		f, err := NewTimeFilter(&from, &to, nil)
+		require.NoError(t, err)
+		assert.True(t, f.CreatedFrom.Equal(time.Date(2024, 8, 31, 21, 0, 0, 0, time.UTC)))
+		assert.Nil(t, f.UpdatedSince)
+	})
+
+	t.Run("inverted range", func(t *testing.T) {
+		_, err := NewTimeFilter(&to, &from, nil)
+		assert.True(t, errors.Is(errors.Cause(err), domain.ErrValidation))
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		since := "yesterday"
+		_, err := NewTimeFilter(nil, nil, &since)
+		assert.True(t, errors.Is(errors.Cause(err), domain.ErrValidation))
+	})
+}
@@ -0,0 +1,21 @@
+package repositories
+
+import (
+	"context"
+	"time"
+	"tms/src/core/domain"
+)
+
+type ClaimEmailsDTO struct {
+	Limit int
+	// Lease время, на которое выбранные письма откладываются, пока отправитель их обрабатывает
+	Lease time.Duration
+}
+
+type EmailRepository interface {
+	// Append добавляет письма, повторное письмо сотруднику о том же событии игнорируется
+	Append(ctx context.Context, emails ...domain.Email) error
+	// Claim выбирает ожидающие письма, время попытки которых наступило, и откладывает их следующую попытку на Lease
+	Claim(ctx context.Context, dto ClaimEmailsDTO) ([]domain.Email, error)
+	Save(ctx context.Context, email domain.Email) error
+}
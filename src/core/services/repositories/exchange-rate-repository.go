@@ -0,0 +1,27 @@
+package repositories
+
+import (
+	"context"
+	"time"
+	"tms/src/core/domain"
+)
+
+type GetExchangeRateListDTO struct {
+	OrganizationID domain.ID
+	From           *domain.Currency
+	To             *domain.Currency
+	// Date возвращаются только курсы, действующие на эту дату
+	Date   *time.Time
+	Limit  *Limit
+	Offset *Offset
+}
+
+type ExchangeRateRepository interface {
+	// GetList возвращает курсы по парам валют, для каждой пары от новых к старым
+	GetList(ctx context.Context, dto GetExchangeRateListDTO) ([]domain.ExchangeRate, error)
+	Get(ctx context.Context, id domain.ID) (*domain.ExchangeRate, error)
+	// Find возвращает курс обмена from на to организации organizationID, действующий на дату date
+	Find(ctx context.Context, organizationID domain.ID, from, to domain.Currency, date time.Time) (*domain.ExchangeRate, error)
+	Save(ctx context.Context, rate domain.ExchangeRate) error
+	Delete(ctx context.Context, id domain.ID) error
+}
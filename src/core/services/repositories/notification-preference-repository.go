@@ -0,0 +1,12 @@
+package repositories
+
+import (
+	"context"
+	"tms/src/core/domain"
+)
+
+type NotificationPreferenceRepository interface {
+	// Get возвращает настройки сотрудника или domain.ErrNotFound, если он их не менял
+	Get(ctx context.Context, employeeID domain.ID) (*domain.NotificationPreferences, error)
+	Save(ctx context.Context, preferences domain.NotificationPreferences) error
+}
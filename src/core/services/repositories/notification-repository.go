@@ -0,0 +1,28 @@
+package repositories
+
+import (
+	"context"
+	"time"
+	"tms/src/core/domain"
+)
+
+type GetNotificationsListDTO struct {
+	RecipientID domain.ID
+	// Unread возвращаются только непрочитанные уведомления
+	Unread bool
+	Offset *Offset
+	Limit  *Limit
+}
+
+type NotificationRepository interface {
+	// GetList возвращает уведомления сотрудника, начиная с последних
+	GetList(ctx context.Context, dto GetNotificationsListDTO) ([]domain.Notification, error)
+	// CountUnread возвращает кол-во непрочитанных уведомлений сотрудника
+	CountUnread(ctx context.Context, recipientID domain.ID) (int, error)
+	Get(ctx context.Context, id domain.ID) (*domain.Notification, error)
+	// Append добавляет уведомления, повторное уведомление сотрудника о том же событии игнорируется
+	Append(ctx context.Context, notifications ...domain.Notification) error
+	Save(ctx context.Context, notification domain.Notification) error
+	// ReadAll отмечает прочитанными все уведомления сотрудника и возвращает их кол-во
+	ReadAll(ctx context.Context, recipientID domain.ID, readAt time.Time) (int, error)
+}
@@ -0,0 +1,46 @@
+package repositories
+
+import (
+	"context"
+	"time"
+	"tms/src/core/domain"
+)
+
+type ClaimOutboxDTO struct {
+	Limit int
+	// MaxAttempts сообщения, исчерпавшие попытки доставки, больше не выбираются
+	MaxAttempts int
+	// Lease время, на которое выбранные сообщения откладываются для остальных вызовов Claim
+	Lease time.Duration
+}
+
+type GetOutboxListDTO struct {
+	// AfterStreamSeq возвращаются сообщения с StreamSeq строго больше
+	AfterStreamSeq int64
+	Limit          int
+}
+
+type OutboxRepository interface {
+	Get(ctx context.Context, id domain.ID) (*domain.OutboxMessage, error)
+	// GetList возвращает сообщения, попавшие в поток событий, по возрастанию StreamSeq
+	GetList(ctx context.Context, dto GetOutboxListDTO) ([]domain.OutboxMessage, error)
+	// LastStreamSeq возвращает наибольший StreamSeq или 0, если поток пуст
+	LastStreamSeq(ctx context.Context) (int64, error)
+	// AssignStreamSeq присваивает сообщению следующий StreamSeq, если он еще не присвоен. Вызывается в транзакции,
+	// номера которой выдаются под блокировкой до ее фиксации, поэтому меньший номер не может быть зафиксирован позже большего
+	AssignStreamSeq(ctx context.Context, id domain.ID) error
+	Append(ctx context.Context, messages ...domain.OutboxMessage) error
+	// Claim выбирает готовые к доставке сообщения в порядке записи и откладывает их следующую попытку на dto.Lease,
+	// чтобы их не выбрал другой экземпляр приложения, пока идет доставка. Каждая выборка выдает сообщениям новый LockToken
+	Claim(ctx context.Context, dto ClaimOutboxDTO) ([]domain.OutboxMessage, error)
+	// MarkSubscriberDelivered отмечает доставку сообщения подписчику subscriber.
+	// Возвращает false, если доставка уже отмечена
+	MarkSubscriberDelivered(ctx context.Context, id domain.ID, subscriber string) (bool, error)
+	// MarkDelivered и следующие методы записывают результат доставки, только пока действует аренда сообщения m,
+	// иначе возвращают ErrLeaseLost
+	MarkDelivered(ctx context.Context, m domain.OutboxMessage) error
+	// MarkFailed увеличивает счетчик попыток и откладывает следующую попытку до nextAttemptAt
+	MarkFailed(ctx context.Context, m domain.OutboxMessage, nextAttemptAt time.Time, reason string) error
+	// MarkDead увеличивает счетчик попыток и переводит сообщение в dead-letter список, из которого оно больше не выбирается
+	MarkDead(ctx context.Context, m domain.OutboxMessage, reason string) error
+}
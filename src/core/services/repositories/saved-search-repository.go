@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+	"tms/src/core/domain"
+)
+
+type SavedSearchRepository interface {
+	// GetList возвращает сохраненные поиски сотрудника в порядке создания
+	GetList(ctx context.Context, employeeID domain.ID) ([]domain.SavedSearch, error)
+	Get(ctx context.Context, id domain.ID) (*domain.SavedSearch, error)
+	// GetMatching возвращает сохраненные поиски, под которые подходит тендер tenderID
+	GetMatching(ctx context.Context, tenderID domain.ID) ([]domain.SavedSearch, error)
+	Save(ctx context.Context, search domain.SavedSearch) error
+	Delete(ctx context.Context, id domain.ID) error
+}
@@ -0,0 +1,23 @@
+package repositories
+
+import (
+	"context"
+	"tms/src/core/domain"
+)
+
+type GetBidScoresDTO struct {
+	TenderID    *domain.ID
+	BidID       *domain.ID
+	BidIDs      []domain.ID
+	EvaluatorID *domain.ID
+}
+
+type ScoringRepository interface {
+	// GetCriteria возвращает критерии оценки тендера в заданном порядке, пустой список если они не заданы
+	GetCriteria(ctx context.Context, tenderID domain.ID) (domain.ScoringCriteria, error)
+	// SaveCriteria заменяет критерии оценки тендера
+	SaveCriteria(ctx context.Context, tenderID domain.ID, criteria domain.ScoringCriteria) error
+	GetScores(ctx context.Context, dto GetBidScoresDTO) ([]domain.BidScore, error)
+	// SaveScores сохраняет оценки, заменяя прежние оценки того же ответственного по тем же критериям
+	SaveScores(ctx context.Context, scores []domain.BidScore) error
+}
@@ -0,0 +1,107 @@
+package repositories
+
+import (
+	"github.com/pkg/errors"
+	"strconv"
+	"strings"
+	"tms/src/core/domain"
+)
+
+// maxSearchQueryLength Максимальная длина поискового запроса в символах
+const maxSearchQueryLength = 200
+
+// NewSearchQuery нормализует поисковый запрос, пустой запрос означает отсутствие поиска
+func NewSearchQuery(str *string) (*string, error) {
+	if str == nil {
+		return nil, nil
+	}
+
+	q := strings.TrimSpace(*str)
+	if q == "" {
+		return nil, nil
+	}
+
+	if len([]rune(q)) > maxSearchQueryLength {
+		return nil, errors.Wrapf(domain.ErrValidation, "search query cannot exceed %d characters", maxSearchQueryLength)
+	}
+
+	return &q, nil
+}
+
+// SearchMatch Результат полнотекстового поиска по объекту
+type SearchMatch struct {
+	// Rank релевантность объекта запросу, чем больше, тем лучше
+	Rank float32 `json:"rank"`
+	// Name и Description фрагменты полей, совпадения выделены тегом <mark>
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// TenderSearchResult Тендер в выдаче списка, Search заполнен только при поиске
+type TenderSearchResult struct {
+	domain.Tender
+	Search *SearchMatch `json:"search,omitempty"`
+}
+
+// Cursor создает курсор, указывающий на элемент выдачи
+func (r TenderSearchResult) Cursor(sort Sort) Cursor {
+	if sort.Field == SortByRelevance && r.Search != nil {
+		return relevanceCursor(sort, r.Search.Rank, r.ID)
+	}
+	return TenderCursor(sort, r.Tender)
+}
+
+// TenderSearchResults оборачивает тендеры, полученные без поиска
+func TenderSearchResults(tenders []domain.Tender) []TenderSearchResult {
+	results := make([]TenderSearchResult, 0, len(tenders))
+	for _, t := range tenders {
+		results = append(results, TenderSearchResult{Tender: t})
+	}
+	return results
+}
+
+// BidSearchResult Предложение в выдаче списка, Search заполнен только при поиске
+type BidSearchResult struct {
+	domain.Bid
+	Search *SearchMatch `json:"search,omitempty"`
+	// NormalizedPrice цена в валюте бюджета тендера, заполняется по запросу, если для нее нашелся курс
+	NormalizedPrice *domain.Money `json:"normalizedPrice,omitempty"`
+	// Score и Criteria взвешенная оценка и средние оценки по критериям тендера, заполняются при сортировке по оценке
+	Score    *float64                `json:"score,omitempty"`
+	Criteria []domain.CriterionScore `json:"criteria,omitempty"`
+}
+
+// Cursor создает курсор, указывающий на элемент выдачи
+func (r BidSearchResult) Cursor(sort Sort) Cursor {
+	if sort.Field == SortByRelevance && r.Search != nil {
+		return relevanceCursor(sort, r.Search.Rank, r.ID)
+	}
+	if sort.Field == SortByNormalizedPrice {
+		value := "0"
+		if r.NormalizedPrice != nil {
+			value = strconv.FormatInt(r.NormalizedPrice.Amount, 10)
+		}
+		return Cursor{Sort: sort, Value: value, ID: r.ID}
+	}
+	if sort.Field == SortByScore {
+		value := "0"
+		if r.Score != nil {
+			value = strconv.FormatFloat(*r.Score, 'g', -1, 64)
+		}
+		return Cursor{Sort: sort, Value: value, ID: r.ID}
+	}
+	return BidCursor(sort, r.Bid)
+}
+
+// BidSearchResults оборачивает предложения, полученные без поиска
+func BidSearchResults(bids []domain.Bid) []BidSearchResult {
+	results := make([]BidSearchResult, 0, len(bids))
+	for _, b := range bids {
+		results = append(results, BidSearchResult{Bid: b})
+	}
+	return results
+}
+
+func relevanceCursor(sort Sort, rank float32, id domain.ID) Cursor {
+	return Cursor{Sort: sort, Value: strconv.FormatFloat(float64(rank), 'g', -1, 32), ID: id}
+}
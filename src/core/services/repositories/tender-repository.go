@@ -2,15 +2,24 @@ package repositories
 
 import (
 	"context"
+	"time"
 	"tms/src/core/domain"
 )
 
 type GetTendersListDTO struct {
-	OrganizationID *domain.ID
-	Status         *domain.TenderStatus
-	ServiceType    *domain.TenderServiceType
-	Offset         *Offset
-	Limit          *Limit
+	IDs             []domain.ID
+	OrganizationIDs []domain.ID
+	Statuses        []domain.TenderStatus
+	ServiceTypes    []domain.TenderServiceType
+	Budget          AmountFilter
+	TimeFilter
+	// Query полнотекстовый поисковый запрос
+	Query *string
+	Sort  *Sort
+	// After курсор keyset пагинации, возвращаются элементы строго после него
+	After  *Cursor
+	Offset *Offset
+	Limit  *Limit
 }
 
 type GetTenderDTO struct {
@@ -18,8 +27,31 @@ type GetTenderDTO struct {
 	OrganizationID *domain.ID
 }
 
+type ClaimExpiredTendersDTO struct {
+	Limit int
+	// Now момент, на который проверяется срок. Передается тот же, что и в domain.Tender.CloseExpired
+	Now time.Time
+}
+
+type ClaimScheduledTendersDTO struct {
+	Limit int
+	// Now момент, на который проверяется время публикации. Передается тот же, что и в domain.Tender.PublishScheduled
+	Now time.Time
+}
+
 type TenderRepository interface {
 	GetList(ctx context.Context, dto GetTendersListDTO) ([]domain.Tender, error)
+	// Search то же, что и GetList, но дополнительно возвращает релевантность и фрагменты с совпадениями dto.Query
+	Search(ctx context.Context, dto GetTendersListDTO) ([]TenderSearchResult, error)
+	// Count возвращает кол-во тендеров, подходящих под фильтры dto, без учета пагинации
+	Count(ctx context.Context, dto GetTendersListDTO) (int, error)
 	Get(ctx context.Context, dto GetTenderDTO) (*domain.Tender, error)
+	// GetStatusHistory возвращает журнал изменений статуса тендера по возрастанию времени
+	GetStatusHistory(ctx context.Context, tenderID domain.ID) ([]domain.TenderStatusChange, error)
 	Save(ctx context.Context, tender domain.Tender) error
+	// ClaimExpired блокирует до конца транзакции незакрытые тендеры с истекшим сроком приема предложений
+	// и возвращает их идентификаторы. Тендеры, заблокированные другой транзакцией, пропускаются
+	ClaimExpired(ctx context.Context, dto ClaimExpiredTendersDTO) ([]domain.ID, error)
+	// ClaimScheduled то же, что и ClaimExpired, для созданных тендеров, время публикации которых наступило
+	ClaimScheduled(ctx context.Context, dto ClaimScheduledTendersDTO) ([]domain.ID, error)
 }
@@ -0,0 +1,8 @@
+package repositories
+
+import "context"
+
+// Transactor выполняет fn в одной транзакции: репозитории, вызванные с переданным в fn ctx, работают внутри нее
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,48 @@
+package repositories
+
+import (
+	"context"
+	"time"
+	"tms/src/core/domain"
+)
+
+type GetWebhooksListDTO struct {
+	OrganizationID domain.ID
+	// Event возвращаются только вебхуки, подписанные на это событие
+	Event *domain.EventName
+}
+
+type GetWebhookDeliveriesListDTO struct {
+	WebhookID domain.ID
+	Status    *domain.WebhookDeliveryStatus
+	Offset    *Offset
+	Limit     *Limit
+}
+
+type ClaimWebhookDeliveriesDTO struct {
+	Limit int
+	// Lease время, на которое выбранные доставки откладываются, пока отправитель их обрабатывает
+	Lease time.Duration
+}
+
+type WebhookRepository interface {
+	GetList(ctx context.Context, dto GetWebhooksListDTO) ([]domain.Webhook, error)
+	Get(ctx context.Context, id domain.ID) (*domain.Webhook, error)
+	Save(ctx context.Context, webhook domain.Webhook) error
+	// Delete удаляет вебхук вместе с его доставками
+	Delete(ctx context.Context, id domain.ID) error
+}
+
+type WebhookDeliveryRepository interface {
+	// GetList возвращает доставки вебхука, начиная с последних
+	GetList(ctx context.Context, dto GetWebhookDeliveriesListDTO) ([]domain.WebhookDelivery, error)
+	Get(ctx context.Context, id domain.ID) (*domain.WebhookDelivery, error)
+	// Append добавляет доставки, повторная доставка события тому же вебхуку игнорируется
+	Append(ctx context.Context, deliveries ...domain.WebhookDelivery) error
+	// Claim выбирает ожидающие доставки, время попытки которых наступило, и откладывает их следующую попытку на Lease.
+	// Каждая выборка выдает доставкам новый LockToken
+	Claim(ctx context.Context, dto ClaimWebhookDeliveriesDTO) ([]domain.WebhookDelivery, error)
+	// Save сохраняет доставку, только если ее LockToken совпадает с сохраненным, иначе возвращает ErrLeaseLost.
+	// Так отправитель с истекшей арендой не перезапишет результат доставки, выбранной заново
+	Save(ctx context.Context, delivery domain.WebhookDelivery) error
+}
@@ -0,0 +1,169 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/events"
+	"tms/src/core/services/repositories"
+	"tms/src/pkg/jobs"
+)
+
+const (
+	CloseExpiredTendersJob     = "close_expired_tenders"
+	PublishScheduledTendersJob = "publish_scheduled_tenders"
+)
+
+type Config struct {
+	PollInterval time.Duration
+	BatchSize    int
+}
+
+// TenderWorker периодической задачей выбирает тендеры, которые сервис должен изменить сам, и изменяет их.
+// Изменение записывается в журнал статусов, outbox и журнал аудита так же, как при ручном изменении статуса
+type TenderWorker struct {
+	name             string
+	kind             string
+	claim            func(ctx context.Context, limit int, now time.Time) ([]domain.ID, error)
+	apply            func(tender *domain.Tender, now time.Time) bool
+	transactor       repositories.Transactor
+	tenderRepository repositories.TenderRepository
+	auditRepository  repositories.AuditRepository
+	dispatcher       events.Dispatcher
+	cfg              Config
+	log              *slog.Logger
+}
+
+// NewTenderCloser закрывает тендеры с истекшим сроком приема предложений
+func NewTenderCloser(
+	transactor repositories.Transactor,
+	tenderRepository repositories.TenderRepository,
+	auditRepository repositories.AuditRepository,
+	dispatcher events.Dispatcher,
+	cfg Config,
+	log *slog.Logger,
+) *TenderWorker {
+	return &TenderWorker{
+		name: "tender closer",
+		kind: CloseExpiredTendersJob,
+		claim: func(ctx context.Context, limit int, now time.Time) ([]domain.ID, error) {
+			return tenderRepository.ClaimExpired(ctx, repositories.ClaimExpiredTendersDTO{Limit: limit, Now: now})
+		},
+		apply:            (*domain.Tender).CloseExpired,
+		transactor:       transactor,
+		tenderRepository: tenderRepository,
+		auditRepository:  auditRepository,
+		dispatcher:       dispatcher,
+		cfg:              cfg,
+		log:              log,
+	}
+}
+
+// NewTenderPublisher публикует тендеры, время запланированной публикации которых наступило
+func NewTenderPublisher(
+	transactor repositories.Transactor,
+	tenderRepository repositories.TenderRepository,
+	auditRepository repositories.AuditRepository,
+	dispatcher events.Dispatcher,
+	cfg Config,
+	log *slog.Logger,
+) *TenderWorker {
+	return &TenderWorker{
+		name: "tender publisher",
+		kind: PublishScheduledTendersJob,
+		claim: func(ctx context.Context, limit int, now time.Time) ([]domain.ID, error) {
+			return tenderRepository.ClaimScheduled(ctx, repositories.ClaimScheduledTendersDTO{Limit: limit, Now: now})
+		},
+		apply:            (*domain.Tender).PublishScheduled,
+		transactor:       transactor,
+		tenderRepository: tenderRepository,
+		auditRepository:  auditRepository,
+		dispatcher:       dispatcher,
+		cfg:              cfg,
+		log:              log,
+	}
+}
+
+// Register регистрирует обработчик как периодическую задачу, выполняемую одним экземпляром приложения раз в PollInterval
+func (w *TenderWorker) Register(runner *jobs.Runner) {
+	runner.Every(w.kind, w.cfg.PollInterval, w.Handle)
+}
+
+// Handle обрабатывает пачки тендеров, пока изменяется полная пачка
+func (w *TenderWorker) Handle(ctx context.Context, _ jobs.Job) error {
+	for {
+		n, err := w.Process(ctx)
+		if err != nil {
+			return err
+		}
+		if n < w.cfg.BatchSize {
+			return nil
+		}
+	}
+}
+
+// Process обрабатывает одну пачку тендеров и возвращает кол-во измененных.
+// Выборка и изменение используют один момент now, поэтому выбранный тендер не может оказаться неизменным
+// из-за расхождения часов приложения и базы
+func (w *TenderWorker) Process(ctx context.Context) (int, error) {
+	var n int
+
+	err := w.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		now := time.Now()
+
+		ids, err := w.claim(ctx, w.cfg.BatchSize, now)
+		if err != nil {
+			return err
+		}
+
+		for _, id := range ids {
+			applied, err := w.process(ctx, id, now)
+			if err != nil {
+				return err
+			}
+			if applied {
+				n++
+			}
+		}
+
+		return nil
+	})
+
+	return n, err
+}
+
+// process изменяет тендер id и сообщает, было ли изменение
+func (w *TenderWorker) process(ctx context.Context, id domain.ID, now time.Time) (bool, error) {
+	tender, err := w.tenderRepository.Get(ctx, repositories.GetTenderDTO{ID: id})
+	if err != nil {
+		return false, err
+	}
+
+	before := *tender
+	if !w.apply(tender, now) {
+		return false, nil
+	}
+
+	entry, err := domain.NewAuditEntry(domain.AuditStatusChangeAction, domain.SystemEditorID, tender.OrganizationID,
+		domain.AuditTenderEntity, tender.ID, before, tender, "")
+	if err != nil {
+		return false, err
+	}
+
+	if err := w.tenderRepository.Save(ctx, *tender); err != nil {
+		return false, err
+	}
+	if err := w.dispatcher.Dispatch(ctx, tender.PullEvents()...); err != nil {
+		return false, err
+	}
+	if err := w.auditRepository.Save(ctx, *entry); err != nil {
+		return false, err
+	}
+
+	w.log.Info(w.name+" changed tender status",
+		slog.String("tenderId", string(tender.ID)),
+		slog.String("status", string(tender.Status)))
+
+	return true, nil
+}
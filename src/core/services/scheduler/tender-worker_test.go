@@ -0,0 +1,54 @@
+package scheduler
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"log/slog"
+	"testing"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+	"tms/src/pkg/jobs"
+)
+
+type fakeTransactor struct{}
+
+func (fakeTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// fakeTenders всегда выбирает одни и те же тендеры, как база, часы которой спешат относительно приложения
+type fakeTenders struct {
+	repositories.TenderRepository
+	tenders map[domain.ID]domain.Tender
+	now     []time.Time
+}
+
+func (f *fakeTenders) ClaimExpired(_ context.Context, dto repositories.ClaimExpiredTendersDTO) ([]domain.ID, error) {
+	f.now = append(f.now, dto.Now)
+
+	ids := make([]domain.ID, 0, len(f.tenders))
+	for id := range f.tenders {
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func (f *fakeTenders) Get(_ context.Context, dto repositories.GetTenderDTO) (*domain.Tender, error) {
+	tender := f.tenders[dto.ID]
+	return &tender, nil
+}
+
+func TestTenderWorker_StopsWhenNothingApplied(t *testing.T) {
+	executor := domain.OrganizationResponsible{OrganizationID: "org", UserID: "responsible"}
+	tender, err := domain.NewTender("Доставка", "Описание", string(domain.TenderDeliveryServiceType), "org", nil, nil, nil, executor)
+	require.NoError(t, err)
+
+	tenders := &fakeTenders{tenders: map[domain.ID]domain.Tender{tender.ID: *tender}}
+	worker := NewTenderCloser(fakeTransactor{}, tenders, nil, nil, Config{BatchSize: 1}, slog.Default())
+
+	require.NoError(t, worker.Handle(context.Background(), jobs.Job{}))
+	require.Len(t, tenders.now, 1)
+	assert.False(t, tenders.now[0].IsZero())
+}
@@ -0,0 +1,152 @@
+package stream
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"slices"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+// Viewer Пользователь, подписанный на поток
+type Viewer struct {
+	EmployeeID domain.ID
+	// OrganizationID организация, за которую отвечает пользователь, nil для остальных пользователей
+	OrganizationID *domain.ID
+}
+
+// Audience Пользователи, которым видно событие
+type Audience struct {
+	// Public событие видно всем пользователям
+	Public          bool
+	OrganizationIDs []domain.ID
+	EmployeeIDs     []domain.ID
+}
+
+func (a Audience) Visible(v Viewer) bool {
+	if a.Public || slices.Contains(a.EmployeeIDs, v.EmployeeID) {
+		return true
+	}
+	return v.OrganizationID != nil && slices.Contains(a.OrganizationIDs, *v.OrganizationID)
+}
+
+// Resolver определяет Audience событий.
+// События тендера и оценки предложений видны организации тендера, а публикация тендера - всем.
+// События предложения видны его автору, а публикация предложения, решение по нему и изменения
+// опубликованного предложения - еще и организации тендера
+type Resolver struct {
+	tenderRepository repositories.TenderRepository
+	bidRepository    repositories.BidRepository
+}
+
+func NewResolver(tenderRepository repositories.TenderRepository, bidRepository repositories.BidRepository) Resolver {
+	return Resolver{
+		tenderRepository: tenderRepository,
+		bidRepository:    bidRepository,
+	}
+}
+
+func (r Resolver) Audience(ctx context.Context, event domain.Event) (Audience, error) {
+	audiences, err := r.Audiences(ctx, []domain.Event{event})
+	if err != nil {
+		return Audience{}, err
+	}
+	return audiences[0], nil
+}
+
+// bidEvent событие предложения, Audience которого зависит от предложения и его тендера
+type bidEvent struct {
+	bidID, tenderID domain.ID
+	// withTender событие видно организации тендера независимо от статуса предложения
+	withTender bool
+}
+
+// Audiences определяет Audience каждого из events. Предложения и тендеры загружаются одним запросом на все события
+func (r Resolver) Audiences(ctx context.Context, events []domain.Event) ([]Audience, error) {
+	audiences := make([]Audience, len(events))
+	pending := make(map[int]bidEvent)
+
+	for i, event := range events {
+		switch e := event.(type) {
+		case domain.TenderCreated:
+			audiences[i] = Audience{OrganizationIDs: []domain.ID{e.OrganizationID}}
+		case domain.TenderEdited:
+			audiences[i] = Audience{OrganizationIDs: []domain.ID{e.OrganizationID}}
+		case domain.TenderRolledBack:
+			audiences[i] = Audience{OrganizationIDs: []domain.ID{e.OrganizationID}}
+		case domain.TenderStatusChanged:
+			audiences[i] = Audience{
+				Public:          e.To == domain.TenderPublishedStatus,
+				OrganizationIDs: []domain.ID{e.OrganizationID},
+			}
+		case domain.TenderCriteriaSet:
+			audiences[i] = Audience{OrganizationIDs: []domain.ID{e.OrganizationID}}
+		case domain.BidScored:
+			audiences[i] = Audience{OrganizationIDs: []domain.ID{e.OrganizationID}}
+		case domain.BidSubmitted:
+			audiences[i] = Audience{EmployeeIDs: []domain.ID{e.AuthorID}}
+		case domain.BidEdited:
+			pending[i] = bidEvent{bidID: e.BidID, tenderID: e.TenderID}
+		case domain.BidRolledBack:
+			pending[i] = bidEvent{bidID: e.BidID, tenderID: e.TenderID}
+		case domain.BidStatusChanged:
+			pending[i] = bidEvent{bidID: e.BidID, tenderID: e.TenderID, withTender: e.To == domain.BidPublishedStatus}
+		case domain.DecisionMade:
+			pending[i] = bidEvent{bidID: e.BidID, tenderID: e.TenderID, withTender: true}
+		}
+	}
+
+	if len(pending) == 0 {
+		return audiences, nil
+	}
+
+	bidIDs := make([]domain.ID, 0, len(pending))
+	for _, e := range pending {
+		bidIDs = append(bidIDs, e.bidID)
+	}
+	bidList, err := r.bidRepository.GetList(ctx, repositories.GetBidListDTO{IDs: bidIDs})
+	if err != nil {
+		return nil, err
+	}
+	bids := make(map[domain.ID]domain.Bid, len(bidList))
+	for _, bid := range bidList {
+		bids[bid.ID] = bid
+	}
+
+	tenderIDs := make([]domain.ID, 0)
+	for _, e := range pending {
+		bid, ok := bids[e.bidID]
+		if !ok {
+			return nil, errors.Wrapf(domain.ErrNotFound, "bid %s not found", e.bidID)
+		}
+		if e.withTender || bid.Status == domain.BidPublishedStatus {
+			tenderIDs = append(tenderIDs, e.tenderID)
+		}
+	}
+
+	organizations := make(map[domain.ID]domain.ID)
+	if len(tenderIDs) > 0 {
+		tenders, err := r.tenderRepository.GetList(ctx, repositories.GetTendersListDTO{IDs: tenderIDs})
+		if err != nil {
+			return nil, err
+		}
+		for _, tender := range tenders {
+			organizations[tender.ID] = tender.OrganizationID
+		}
+	}
+
+	for i, e := range pending {
+		bid := bids[e.bidID]
+		audiences[i] = Audience{EmployeeIDs: []domain.ID{bid.AuthorID}}
+
+		if e.withTender || bid.Status == domain.BidPublishedStatus {
+			organizationID, ok := organizations[e.tenderID]
+			if !ok {
+				return nil, errors.Wrapf(domain.ErrNotFound, "tender %s not found", e.tenderID)
+			}
+			audiences[i].OrganizationIDs = []domain.ID{organizationID}
+		}
+	}
+
+	return audiences, nil
+}
@@ -0,0 +1,327 @@
+package stream
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/events"
+	"tms/src/core/services/repositories"
+	"tms/src/pkg/logger/sl"
+)
+
+// Channel канал Postgres NOTIFY, через который экземпляры приложения узнают о доставленных событиях
+const Channel = "tms_events"
+
+const (
+	// bufferSize сообщений, которые подписчик может не успеть прочитать, прежде чем будет отключен
+	bufferSize = 64
+	// replayLimit событий, которые восстанавливаются по Last-Event-ID. Если пропущено больше, вместо них
+	// отправляется ResetEvent
+	replayLimit    = 1000
+	reconnectDelay = time.Second
+)
+
+// ResetEvent событие, которое получает клиент, пропустивший больше replayLimit событий.
+// Клиент должен заново загрузить данные, id события - номер, с которого продолжается поток
+const ResetEvent domain.EventName = "reset"
+
+type Listener interface {
+	Listen(ctx context.Context, channel string, handle func(payload string)) error
+}
+
+type Notifier interface {
+	Notify(ctx context.Context, channel, payload string) error
+}
+
+// NotifyHandler подписчик событий, присваивающий событию номер в потоке и оповещающий все экземпляры приложения
+// через Channel. Выполняется в транзакции доставки, поэтому номера выдаются в порядке ее фиксации,
+// а не записи в outbox, и восстановление по Last-Event-ID не пропускает события, зафиксированные позже
+func NotifyHandler(outboxRepository repositories.OutboxRepository, notifier Notifier) events.Handler {
+	return func(ctx context.Context, event domain.Event) error {
+		if err := outboxRepository.AssignStreamSeq(ctx, event.Meta().EventID); err != nil {
+			return err
+		}
+		return notifier.Notify(ctx, Channel, string(event.Meta().EventID))
+	}
+}
+
+// Message Событие потока
+type Message struct {
+	// Seq порядковый номер события в потоке, передается клиенту как id
+	Seq   int64
+	Event domain.EventName
+	// TenderID тендер, к которому относится событие
+	TenderID domain.ID
+	Data     json.RawMessage
+	Audience Audience
+}
+
+// Subscription Подписка пользователя на поток
+type Subscription struct {
+	viewer  Viewer
+	visible func(Message) bool
+	// Backlog пропущенные пользователем события, если подписка восстановлена по Last-Event-ID
+	Backlog []Message
+	// C новые события. Канал закрывается, если подписчик не успевает их читать
+	C        <-chan Message
+	messages chan Message
+	replayed map[int64]struct{}
+	// resetSeq номер ResetEvent в Backlog, более ранние события клиент загрузит заново
+	resetSeq int64
+	hub      *Hub
+}
+
+// Replayed сообщает, было ли событие с номером seq в Backlog или перекрыто ResetEvent. Такие события
+// могут прийти и в C, если были доставлены во время восстановления подписки
+func (s *Subscription) Replayed(seq int64) bool {
+	_, ok := s.replayed[seq]
+	return ok || seq <= s.resetSeq
+}
+
+// Close отменяет подписку
+func (s *Subscription) Close() {
+	s.hub.unsubscribe(s)
+}
+
+// Hub Рассылает события, полученные через Postgres LISTEN, подписчикам этого экземпляра приложения
+type Hub struct {
+	listener         Listener
+	outboxRepository repositories.OutboxRepository
+	resolver         Resolver
+	log              *slog.Logger
+
+	mu          sync.Mutex
+	subscribers map[*Subscription]struct{}
+}
+
+func NewHub(listener Listener, outboxRepository repositories.OutboxRepository, resolver Resolver, log *slog.Logger) *Hub {
+	return &Hub{
+		listener:         listener,
+		outboxRepository: outboxRepository,
+		resolver:         resolver,
+		log:              log,
+		subscribers:      make(map[*Subscription]struct{}),
+	}
+}
+
+// Run слушает Channel до отмены ctx, переподключаясь при разрыве соединения.
+// После остановки все подписки закрываются, чтобы открытые потоки не задерживали остановку сервера
+func (h *Hub) Run(ctx context.Context) {
+	defer h.closeAll()
+
+	for {
+		err := h.listener.Listen(ctx, Channel, func(payload string) {
+			h.receive(ctx, domain.ID(payload))
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		h.log.Error("event stream listener failed", sl.Err(err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(reconnectDelay):
+		}
+	}
+}
+
+// Subscribe подписывает viewer на события. Если передан lastSeq, в Backlog попадают видимые viewer события после него,
+// а если их больше replayLimit - только ResetEvent
+func (h *Hub) Subscribe(ctx context.Context, viewer Viewer, lastSeq *int64) (*Subscription, error) {
+	// Подписка оформляется до чтения outbox, чтобы не пропустить события, доставленные во время восстановления
+	s := h.subscribe(viewer, func(m Message) bool {
+		return m.Audience.Visible(viewer)
+	})
+
+	if lastSeq == nil {
+		return s, nil
+	}
+
+	backlog, err := h.replay(ctx, viewer, *lastSeq)
+	if err != nil {
+		s.Close()
+		return nil, err
+	}
+
+	s.Backlog = backlog
+	for _, m := range backlog {
+		if m.Event == ResetEvent {
+			s.resetSeq = m.Seq
+			continue
+		}
+		s.replayed[m.Seq] = struct{}{}
+	}
+
+	return s, nil
+}
+
+// SubscribeTender подписывает viewer на события предложений тендера tenderID и решения по ним.
+// Доступ viewer к тендеру проверяется до подписки, а события, как и в Subscribe, получает только их Audience,
+// поэтому изменения еще не опубликованных предложений видны лишь их авторам
+func (h *Hub) SubscribeTender(viewer Viewer, tenderID domain.ID) *Subscription {
+	return h.subscribe(viewer, func(m Message) bool {
+		return m.TenderID == tenderID && slices.Contains(tenderEvents, m.Event) && m.Audience.Visible(viewer)
+	})
+}
+
+// tenderEvents события, рассылаемые подписчикам тендера
+var tenderEvents = []domain.EventName{
+	domain.BidSubmittedEvent,
+	domain.BidEditedEvent,
+	domain.BidRolledBackEvent,
+	domain.BidPublishedEvent,
+	domain.DecisionMadeEvent,
+}
+
+func (h *Hub) subscribe(viewer Viewer, visible func(Message) bool) *Subscription {
+	messages := make(chan Message, bufferSize)
+	s := &Subscription{
+		viewer:   viewer,
+		visible:  visible,
+		C:        messages,
+		messages: messages,
+		replayed: make(map[int64]struct{}),
+		hub:      h,
+	}
+
+	h.mu.Lock()
+	h.subscribers[s] = struct{}{}
+	h.mu.Unlock()
+
+	return s
+}
+
+func (h *Hub) unsubscribe(s *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.subscribers[s]; ok {
+		delete(h.subscribers, s)
+		close(s.messages)
+	}
+}
+
+func (h *Hub) closeAll() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for s := range h.subscribers {
+		delete(h.subscribers, s)
+		close(s.messages)
+	}
+}
+
+// Publish рассылает message подписчикам, которым оно видно
+func (h *Hub) Publish(message Message) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for s := range h.subscribers {
+		if !s.visible(message) {
+			continue
+		}
+
+		select {
+		case s.messages <- message:
+		default:
+			// Подписчик отключается, а не блокирует рассылку. Он переподключится с Last-Event-ID
+			delete(h.subscribers, s)
+			close(s.messages)
+			h.log.Warn("event stream subscriber is too slow, disconnected", slog.String("employeeId", string(s.viewer.EmployeeID)))
+		}
+	}
+}
+
+func (h *Hub) receive(ctx context.Context, eventID domain.ID) {
+	m, err := h.outboxRepository.Get(ctx, eventID)
+	if err != nil {
+		h.log.Error("cannot load streamed event", slog.String("eventId", string(eventID)), sl.Err(err))
+		return
+	}
+
+	messages, err := h.messages(ctx, []domain.OutboxMessage{*m})
+	if err != nil {
+		h.log.Error("cannot prepare streamed event", slog.String("eventId", string(eventID)), sl.Err(err))
+		return
+	}
+
+	h.Publish(messages[0])
+}
+
+func (h *Hub) replay(ctx context.Context, viewer Viewer, lastSeq int64) ([]Message, error) {
+	batch, err := h.outboxRepository.GetList(ctx, repositories.GetOutboxListDTO{
+		AfterStreamSeq: lastSeq,
+		Limit:          replayLimit + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(batch) > replayLimit {
+		seq, err := h.outboxRepository.LastStreamSeq(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return []Message{{Seq: seq, Event: ResetEvent, Data: json.RawMessage(`{}`), Audience: Audience{Public: true}}}, nil
+	}
+
+	messages, err := h.messages(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+
+	backlog := make([]Message, 0)
+	for _, m := range messages {
+		if m.Audience.Visible(viewer) {
+			backlog = append(backlog, m)
+		}
+	}
+
+	return backlog, nil
+}
+
+func (h *Hub) messages(ctx context.Context, batch []domain.OutboxMessage) ([]Message, error) {
+	events := make([]domain.Event, len(batch))
+	for i, m := range batch {
+		if m.StreamSeq == nil {
+			return nil, errors.Errorf("outbox message %s is not streamed", m.ID)
+		}
+
+		event, err := m.Event()
+		if err != nil {
+			return nil, err
+		}
+		events[i] = event
+	}
+
+	audiences, err := h.resolver.Audiences(ctx, events)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]Message, len(batch))
+	for i, m := range batch {
+		// Данные события передаются одной строкой data
+		var data bytes.Buffer
+		if err := json.Compact(&data, m.Payload); err != nil {
+			return nil, err
+		}
+
+		messages[i] = Message{
+			Seq:      *m.StreamSeq,
+			Event:    m.EventName,
+			TenderID: domain.TenderOf(events[i]),
+			Data:     data.Bytes(),
+			Audience: audiences[i],
+		}
+	}
+
+	return messages, nil
+}
@@ -0,0 +1,193 @@
+package stream
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"log/slog"
+	"slices"
+	"testing"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+type fakeOutbox struct {
+	messages []domain.OutboxMessage
+}
+
+func (f *fakeOutbox) Get(_ context.Context, id domain.ID) (*domain.OutboxMessage, error) {
+	for _, m := range f.messages {
+		if m.ID == id {
+			return &m, nil
+		}
+	}
+	return nil, domain.ErrNotFound
+}
+
+func (f *fakeOutbox) GetList(_ context.Context, dto repositories.GetOutboxListDTO) ([]domain.OutboxMessage, error) {
+	result := make([]domain.OutboxMessage, 0)
+	for _, m := range f.messages {
+		if *m.StreamSeq > dto.AfterStreamSeq && len(result) < dto.Limit {
+			result = append(result, m)
+		}
+	}
+	return result, nil
+}
+
+func (f *fakeOutbox) LastStreamSeq(context.Context) (int64, error) {
+	return int64(len(f.messages)), nil
+}
+
+func (f *fakeOutbox) AssignStreamSeq(context.Context, domain.ID) error { return nil }
+
+// Append сразу присваивает StreamSeq, как если бы сообщения были доставлены в порядке записи
+func (f *fakeOutbox) Append(_ context.Context, messages ...domain.OutboxMessage) error {
+	for _, m := range messages {
+		seq := int64(len(f.messages) + 1)
+		m.Seq, m.StreamSeq = seq, &seq
+		f.messages = append(f.messages, m)
+	}
+	return nil
+}
+
+func (f *fakeOutbox) Claim(context.Context, repositories.ClaimOutboxDTO) ([]domain.OutboxMessage, error) {
+	return nil, nil
+}
+
+func (f *fakeOutbox) MarkSubscriberDelivered(context.Context, domain.ID, string) (bool, error) {
+	return true, nil
+}
+
+func (f *fakeOutbox) MarkDelivered(context.Context, domain.OutboxMessage) error { return nil }
+
+func (f *fakeOutbox) MarkFailed(context.Context, domain.OutboxMessage, time.Time, string) error {
+	return nil
+}
+
+func (f *fakeOutbox) MarkDead(context.Context, domain.OutboxMessage, string) error { return nil }
+
+func (f *fakeOutbox) record(t *testing.T, events ...domain.Event) {
+	for _, event := range events {
+		m, err := domain.NewOutboxMessage(event)
+		require.NoError(t, err)
+		require.NoError(t, f.Append(context.Background(), *m))
+	}
+}
+
+type fakeBids struct {
+	repositories.BidRepository
+	bids []domain.Bid
+}
+
+func (f *fakeBids) GetList(_ context.Context, dto repositories.GetBidListDTO) ([]domain.Bid, error) {
+	result := make([]domain.Bid, 0)
+	for _, b := range f.bids {
+		if slices.Contains(dto.IDs, b.ID) {
+			result = append(result, b)
+		}
+	}
+	return result, nil
+}
+
+func TestHub(t *testing.T) {
+	org := domain.ID("org")
+	executor := domain.OrganizationResponsible{OrganizationID: org, UserID: "responsible"}
+	responsible := Viewer{EmployeeID: "responsible", OrganizationID: &org}
+	outsider := Viewer{EmployeeID: "outsider"}
+
+	tender, err := domain.NewTender("Доставка", "Описание", string(domain.TenderDeliveryServiceType), string(org), nil, nil, nil, executor)
+	require.NoError(t, err)
+	require.NoError(t, tender.ChangeStatus(executor, string(domain.TenderPublishedStatus), nil))
+
+	outbox := &fakeOutbox{}
+	outbox.record(t, tender.PullEvents()...)
+
+	bid, err := domain.NewBid("Предложение", "Описание", string(domain.BidAuthorUserType), domain.Money{Amount: 100, Currency: domain.RUBCurrency}, tender.ID, "author")
+	require.NoError(t, err)
+	require.NoError(t, bid.Edit("author", nil, nil, &domain.Money{Amount: 90, Currency: domain.RUBCurrency}))
+	outbox.record(t, bid.PullEvents()...)
+	bids := &fakeBids{bids: []domain.Bid{*bid}}
+
+	hub := NewHub(nil, outbox, NewResolver(nil, bids), slog.Default())
+
+	t.Run("replays visible events after Last-Event-ID", func(t *testing.T) {
+		lastSeq := int64(0)
+
+		s, err := hub.Subscribe(context.Background(), responsible, &lastSeq)
+		require.NoError(t, err)
+		defer s.Close()
+		require.Len(t, s.Backlog, 2)
+		assert.Equal(t, domain.TenderCreatedEvent, s.Backlog[0].Event)
+		assert.Equal(t, domain.TenderPublishedEvent, s.Backlog[1].Event)
+
+		s, err = hub.Subscribe(context.Background(), outsider, &lastSeq)
+		require.NoError(t, err)
+		defer s.Close()
+		require.Len(t, s.Backlog, 1)
+		assert.Equal(t, int64(2), s.Backlog[0].Seq)
+	})
+
+	t.Run("publishes events to their audience", func(t *testing.T) {
+		own, err := hub.Subscribe(context.Background(), responsible, nil)
+		require.NoError(t, err)
+		defer own.Close()
+
+		other, err := hub.Subscribe(context.Background(), outsider, nil)
+		require.NoError(t, err)
+		defer other.Close()
+
+		hub.receive(context.Background(), outbox.messages[0].ID)
+
+		assert.Equal(t, domain.TenderCreatedEvent, (<-own.C).Event)
+		assert.Empty(t, other.C)
+	})
+
+	t.Run("resets clients that missed too many events", func(t *testing.T) {
+		outbox := &fakeOutbox{}
+		for range replayLimit + 1 {
+			outbox.record(t, domain.TenderCreated{EventMeta: domain.EventMeta{EventID: domain.NewID(), OccurredAt: time.Now()}, TenderID: tender.ID, OrganizationID: org})
+		}
+		hub := NewHub(nil, outbox, NewResolver(nil, nil), slog.Default())
+
+		lastSeq := int64(0)
+		s, err := hub.Subscribe(context.Background(), responsible, &lastSeq)
+		require.NoError(t, err)
+		defer s.Close()
+
+		require.Len(t, s.Backlog, 1)
+		assert.Equal(t, ResetEvent, s.Backlog[0].Event)
+		assert.Equal(t, int64(replayLimit+1), s.Backlog[0].Seq)
+		assert.True(t, s.Replayed(replayLimit))
+		assert.False(t, s.Replayed(replayLimit+2))
+	})
+
+	t.Run("publishes bid events to tender subscribers", func(t *testing.T) {
+		s := hub.SubscribeTender(responsible, tender.ID)
+		defer s.Close()
+
+		audience := Audience{OrganizationIDs: []domain.ID{org}}
+		hub.Publish(Message{Seq: 10, Event: domain.TenderEditedEvent, TenderID: tender.ID, Audience: audience})
+		hub.Publish(Message{Seq: 11, Event: domain.BidSubmittedEvent, TenderID: "other", Audience: audience})
+		hub.Publish(Message{Seq: 12, Event: domain.DecisionMadeEvent, TenderID: tender.ID, Audience: audience})
+
+		assert.Equal(t, int64(12), (<-s.C).Seq)
+		assert.Empty(t, s.C)
+	})
+
+	t.Run("does not broadcast created bids to tender subscribers", func(t *testing.T) {
+		s := hub.SubscribeTender(responsible, tender.ID)
+		defer s.Close()
+
+		author := hub.SubscribeTender(Viewer{EmployeeID: "author"}, tender.ID)
+		defer author.Close()
+
+		for _, m := range outbox.messages[2:] {
+			hub.receive(context.Background(), m.ID)
+		}
+
+		assert.Equal(t, domain.BidSubmittedEvent, (<-author.C).Event)
+		assert.Equal(t, domain.BidEditedEvent, (<-author.C).Event)
+		assert.Empty(t, s.C)
+	})
+}
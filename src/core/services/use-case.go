@@ -0,0 +1,8 @@
+package services
+
+import "context"
+
+// UseCase сценарий, принимающий dto и возвращающий результат R
+type UseCase[D any, R any] interface {
+	Execute(ctx context.Context, dto D) (R, error)
+}
@@ -0,0 +1,123 @@
+package use_cases
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+type GetAuditLogUseCase struct {
+	employeeRepository       repositories.EmployeeRepository
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository
+	auditRepository          repositories.AuditRepository
+}
+
+type GetAuditLogDTO struct {
+	Limit       *int    `json:"limit"`
+	Offset      *int    `json:"offset"`
+	Username    string  `json:"username"`
+	EntityType  *string `json:"entity_type"`
+	EntityID    *string `json:"entity_id"`
+	Action      *string `json:"action"`
+	ActorID     *string `json:"actor_id"`
+	CreatedFrom *string `json:"created_from"`
+	CreatedTo   *string `json:"created_to"`
+	Cursor      *string `json:"cursor"`
+}
+
+// Execute возвращает журнал аудита организации, за которую отвечает сотрудник, от новых записей к старым
+func (uc GetAuditLogUseCase) Execute(dto GetAuditLogDTO) (*repositories.Page[domain.AuditEntry], error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	employee, err := uc.employeeRepository.Get(ctx, repositories.GetEmployeeDTO{
+		Username: &dto.Username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	orgResponsible, err := uc.orgResponsibleRepository.Get(ctx, repositories.GetOrganizationResponsibleDTO{
+		EmployeeID: employee.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	listDTO := repositories.GetAuditListDTO{
+		OrganizationID: orgResponsible.OrganizationID,
+	}
+
+	if dto.EntityType != nil {
+		entityType, err := domain.NewAuditEntityType(*dto.EntityType)
+		if err != nil {
+			return nil, err
+		}
+		listDTO.EntityType = &entityType
+	}
+
+	if dto.Action != nil {
+		action, err := domain.NewAuditAction(*dto.Action)
+		if err != nil {
+			return nil, err
+		}
+		listDTO.Action = &action
+	}
+
+	if dto.EntityID != nil {
+		entityID := domain.ID(*dto.EntityID)
+		listDTO.EntityID = &entityID
+	}
+
+	if dto.ActorID != nil {
+		actorID := domain.ID(*dto.ActorID)
+		listDTO.ActorID = &actorID
+	}
+
+	timeFilter, err := repositories.NewTimeFilter(dto.CreatedFrom, dto.CreatedTo, nil)
+	if err != nil {
+		return nil, err
+	}
+	listDTO.CreatedFrom, listDTO.CreatedTo = timeFilter.CreatedFrom, timeFilter.CreatedTo
+
+	cursor, err := repositories.NewCursor(dto.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	if cursor != nil && cursor.Sort != repositories.AuditSort {
+		return nil, errors.Wrap(domain.ErrValidation, "cursor was not issued for audit log")
+	}
+
+	// Запрашивается на один элемент больше, чтобы понять, есть ли следующая страница
+	limit := repositories.NewLimit(dto.Limit)
+	offset := repositories.NewOffset(dto.Offset)
+	fetch := limit + 1
+	listDTO.After = cursor
+	listDTO.Limit = &fetch
+	if cursor == nil {
+		listDTO.Offset = &offset
+	}
+
+	entries, err := uc.auditRepository.GetList(ctx, listDTO)
+	if err != nil {
+		return nil, err
+	}
+
+	page := repositories.NewPage(entries, limit, repositories.AuditCursor)
+
+	return &page, nil
+}
+
+func NewGetAuditLogUseCase(
+	employeeRepository repositories.EmployeeRepository,
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository,
+	auditRepository repositories.AuditRepository,
+) GetAuditLogUseCase {
+	return GetAuditLogUseCase{
+		employeeRepository:       employeeRepository,
+		orgResponsibleRepository: orgResponsibleRepository,
+		auditRepository:          auditRepository,
+	}
+}
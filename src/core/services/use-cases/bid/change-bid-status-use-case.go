@@ -5,21 +5,31 @@ import (
 	"github.com/pkg/errors"
 	"time"
 	"tms/src/core/domain"
+	"tms/src/core/services/events"
 	"tms/src/core/services/repositories"
 )
 
 type ChangeBidStatusUseCase struct {
 	employeeRepository repositories.EmployeeRepository
+	tenderRepository   repositories.TenderRepository
 	bidRepository      repositories.BidRepository
+	transactor         repositories.Transactor
+	dispatcher         events.Dispatcher
 }
 
 func NewChangeBidStatusUseCase(
 	employeeRepository repositories.EmployeeRepository,
+	tenderRepository repositories.TenderRepository,
 	bidRepository repositories.BidRepository,
+	transactor repositories.Transactor,
+	dispatcher events.Dispatcher,
 ) ChangeBidStatusUseCase {
 	return ChangeBidStatusUseCase{
 		employeeRepository: employeeRepository,
+		tenderRepository:   tenderRepository,
 		bidRepository:      bidRepository,
+		transactor:         transactor,
+		dispatcher:         dispatcher,
 	}
 }
 
@@ -27,10 +37,11 @@ type ChangeBidStatusDTO struct {
 	BidID    string
 	Status   string
 	Username string
+	Reason   *string
 }
 
-func (uc ChangeBidStatusUseCase) Execute(dto ChangeBidStatusDTO) (*domain.Bid, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func (uc ChangeBidStatusUseCase) Execute(ctx context.Context, dto ChangeBidStatusDTO) (*domain.Bid, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	// Проверка существования Employee
@@ -55,13 +66,31 @@ func (uc ChangeBidStatusUseCase) Execute(dto ChangeBidStatusDTO) (*domain.Bid, e
 		return nil, errors.Wrap(domain.ErrNoPermission, "employee is not author of bid")
 	}
 
+	// После окончания приема предложений их статус нельзя изменить
+	tender, err := uc.tenderRepository.Get(ctx, repositories.GetTenderDTO{
+		ID: bid.TenderID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tender.AcceptsBids(time.Now()); err != nil {
+		return nil, err
+	}
+
 	// Изменение статуса Bid
-	if err := bid.ChangeStatus(dto.Status); err != nil {
+	if err := bid.ChangeStatus(employee.ID, dto.Status, dto.Reason); err != nil {
 		return nil, err
 	}
 
-	// Сохранение Bid
-	if err := uc.bidRepository.Save(ctx, *bid); err != nil {
+	// Сохранение Bid вместе с его событиями
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.bidRepository.Save(ctx, *bid); err != nil {
+			return err
+		}
+		return uc.dispatcher.Dispatch(ctx, bid.PullEvents()...)
+	})
+	if err != nil {
 		return nil, err
 	}
 
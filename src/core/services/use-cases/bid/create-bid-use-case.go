@@ -4,37 +4,48 @@ import (
 	"context"
 	"time"
 	"tms/src/core/domain"
+	"tms/src/core/services/events"
 	"tms/src/core/services/repositories"
 )
 
 type CreateBidUseCase struct {
-	employeeRepository repositories.EmployeeRepository
-	tenderRepository   repositories.TenderRepository
-	bidRepository      repositories.BidRepository
+	employeeRepository     repositories.EmployeeRepository
+	tenderRepository       repositories.TenderRepository
+	bidRepository          repositories.BidRepository
+	exchangeRateRepository repositories.ExchangeRateRepository
+	transactor             repositories.Transactor
+	dispatcher             events.Dispatcher
 }
 
 func NewCreateBidUseCase(
 	employeeRepository repositories.EmployeeRepository,
 	tenderRepository repositories.TenderRepository,
 	bidRepository repositories.BidRepository,
+	exchangeRateRepository repositories.ExchangeRateRepository,
+	transactor repositories.Transactor,
+	dispatcher events.Dispatcher,
 ) CreateBidUseCase {
 	return CreateBidUseCase{
-		employeeRepository: employeeRepository,
-		tenderRepository:   tenderRepository,
-		bidRepository:      bidRepository,
+		employeeRepository:     employeeRepository,
+		tenderRepository:       tenderRepository,
+		bidRepository:          bidRepository,
+		exchangeRateRepository: exchangeRateRepository,
+		transactor:             transactor,
+		dispatcher:             dispatcher,
 	}
 }
 
 type CreateBidDTO struct {
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	TenderID    string `json:"tenderId"`
-	AuthorType  string `json:"authorType"`
-	AuthorID    string `json:"authorId"`
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	Price       domain.Money `json:"price"`
+	TenderID    string       `json:"tenderId"`
+	AuthorType  string       `json:"authorType"`
+	AuthorID    string       `json:"authorId"`
 }
 
-func (uc CreateBidUseCase) Execute(dto CreateBidDTO) (*domain.Bid, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func (uc CreateBidUseCase) Execute(ctx context.Context, dto CreateBidDTO) (*domain.Bid, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	// Поверка существования Employee
@@ -55,14 +66,34 @@ func (uc CreateBidUseCase) Execute(dto CreateBidDTO) (*domain.Bid, error) {
 		return nil, err
 	}
 
+	if err := tender.AcceptsBids(time.Now()); err != nil {
+		return nil, err
+	}
+
+	// Цена в другой валюте сравнивается с бюджетом по курсу на дату создания предложения
+	rate, err := priceRate(ctx, uc.exchangeRateRepository, *tender, dto.Price, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tender.CheckPrice(dto.Price, rate); err != nil {
+		return nil, err
+	}
+
 	// Создание Bid
-	bid, err := domain.NewBid(dto.Name, dto.Description, dto.AuthorType, tender.ID, employee.ID)
+	bid, err := domain.NewBid(dto.Name, dto.Description, dto.AuthorType, dto.Price, tender.ID, employee.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	// Сохранение Bid
-	if err := uc.bidRepository.Save(ctx, *bid); err != nil {
+	// Сохранение Bid вместе с его событиями
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.bidRepository.Save(ctx, *bid); err != nil {
+			return err
+		}
+		return uc.dispatcher.Dispatch(ctx, bid.PullEvents()...)
+	})
+	if err != nil {
 		return nil, err
 	}
 
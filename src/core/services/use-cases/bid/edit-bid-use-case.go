@@ -4,21 +4,34 @@ import (
 	"context"
 	"time"
 	"tms/src/core/domain"
+	"tms/src/core/services/events"
 	"tms/src/core/services/repositories"
 )
 
 type EditBidUseCase struct {
-	employeeRepository repositories.EmployeeRepository
-	bidRepository      repositories.BidRepository
+	employeeRepository     repositories.EmployeeRepository
+	tenderRepository       repositories.TenderRepository
+	bidRepository          repositories.BidRepository
+	exchangeRateRepository repositories.ExchangeRateRepository
+	transactor             repositories.Transactor
+	dispatcher             events.Dispatcher
 }
 
 func NewEditBidUseCase(
 	employeeRepository repositories.EmployeeRepository,
+	tenderRepository repositories.TenderRepository,
 	bidRepository repositories.BidRepository,
+	exchangeRateRepository repositories.ExchangeRateRepository,
+	transactor repositories.Transactor,
+	dispatcher events.Dispatcher,
 ) EditBidUseCase {
 	return EditBidUseCase{
-		employeeRepository: employeeRepository,
-		bidRepository:      bidRepository,
+		employeeRepository:     employeeRepository,
+		tenderRepository:       tenderRepository,
+		bidRepository:          bidRepository,
+		exchangeRateRepository: exchangeRateRepository,
+		transactor:             transactor,
+		dispatcher:             dispatcher,
 	}
 }
 
@@ -27,10 +40,11 @@ type EditBidDTO struct {
 	Username    string
 	Name        *string
 	Description *string
+	Price       *domain.Money
 }
 
-func (uc EditBidUseCase) Execute(dto EditBidDTO) (*domain.Bid, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func (uc EditBidUseCase) Execute(ctx context.Context, dto EditBidDTO) (*domain.Bid, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	employee, err := uc.employeeRepository.Get(ctx, repositories.GetEmployeeDTO{
@@ -49,11 +63,40 @@ func (uc EditBidUseCase) Execute(dto EditBidDTO) (*domain.Bid, error) {
 		return nil, err
 	}
 
-	if err := bid.Edit(dto.Name, dto.Description); err != nil {
+	// После окончания приема предложений их нельзя изменить
+	tender, err := uc.tenderRepository.Get(ctx, repositories.GetTenderDTO{
+		ID: bid.TenderID,
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	if err := uc.bidRepository.Save(ctx, *bid); err != nil {
+	if err := tender.AcceptsBids(time.Now()); err != nil {
+		return nil, err
+	}
+
+	if dto.Price != nil {
+		rate, err := priceRate(ctx, uc.exchangeRateRepository, *tender, *dto.Price, bid.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := tender.CheckPrice(*dto.Price, rate); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := bid.Edit(employee.ID, dto.Name, dto.Description, dto.Price); err != nil {
+		return nil, err
+	}
+
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.bidRepository.Save(ctx, *bid); err != nil {
+			return err
+		}
+		return uc.dispatcher.Dispatch(ctx, bid.PullEvents()...)
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,58 @@
+package use_cases
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+// priceRate возвращает курс организации тендера для перевода цены в валюту бюджета, действовавший на дату date.
+// Если перевод не нужен или курса нет, возвращается nil, и цену в другой валюте отклонит CheckPrice
+func priceRate(
+	ctx context.Context,
+	exchangeRateRepository repositories.ExchangeRateRepository,
+	tender domain.Tender,
+	price domain.Money,
+	date time.Time,
+) (*domain.ExchangeRate, error) {
+	if tender.Budget == nil || tender.Budget.Currency == price.Currency {
+		return nil, nil
+	}
+
+	rate, err := exchangeRateRepository.Find(ctx, tender.OrganizationID, price.Currency, tender.Budget.Currency, domain.DateOf(date))
+	if err != nil {
+		if errors.Is(errors.Cause(err), domain.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return rate, nil
+}
+
+// normalizePrices заполняет цены предложений в валюте бюджета их тендеров
+func normalizePrices(ctx context.Context, bidRepository repositories.BidRepository, bids []repositories.BidSearchResult) error {
+	if len(bids) == 0 {
+		return nil
+	}
+
+	ids := make([]domain.ID, 0, len(bids))
+	for _, b := range bids {
+		ids = append(ids, b.ID)
+	}
+
+	prices, err := bidRepository.GetNormalizedPrices(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	for i := range bids {
+		if price, ok := prices[bids[i].ID]; ok {
+			bids[i].NormalizedPrice = &price
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package use_cases
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+type GetBidVersionUseCase struct {
+	employeeRepository repositories.EmployeeRepository
+	bidRepository      repositories.BidRepository
+}
+
+func NewGetBidVersionUseCase(
+	employeeRepository repositories.EmployeeRepository,
+	bidRepository repositories.BidRepository,
+) GetBidVersionUseCase {
+	return GetBidVersionUseCase{
+		employeeRepository: employeeRepository,
+		bidRepository:      bidRepository,
+	}
+}
+
+type GetBidVersionDTO struct {
+	BidID    string
+	Version  int
+	Username string
+}
+
+func (uc GetBidVersionUseCase) Execute(dto GetBidVersionDTO) (*domain.BidSnapshot, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Проверка существования Employee
+	employee, err := uc.employeeRepository.Get(ctx, repositories.GetEmployeeDTO{
+		Username: &dto.Username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Получение Bid
+	bid, err := uc.bidRepository.Get(ctx, repositories.GetBidDTO{
+		ID: domain.ID(dto.BidID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Проверка прав Employee
+	if bid.AuthorID != employee.ID {
+		return nil, errors.Wrap(domain.ErrNoPermission, "employee is not author of bid")
+	}
+
+	return bid.FindVersion(dto.Version)
+}
@@ -13,6 +13,7 @@ type GetBidsOfTenderUseCase struct {
 	orgResponsibleRepository repositories.OrganizationResponsibleRepository
 	tenderRepository         repositories.TenderRepository
 	bidRepository            repositories.BidRepository
+	scoringRepository        repositories.ScoringRepository
 }
 
 func NewGetBidsOfTenderUseCase(
@@ -20,23 +21,38 @@ func NewGetBidsOfTenderUseCase(
 	tenderRepository repositories.TenderRepository,
 	bidRepository repositories.BidRepository,
 	orgResponsibleRepository repositories.OrganizationResponsibleRepository,
+	scoringRepository repositories.ScoringRepository,
 ) GetBidsOfTenderUseCase {
 	return GetBidsOfTenderUseCase{
 		employeeRepository:       employeeRepository,
 		tenderRepository:         tenderRepository,
 		bidRepository:            bidRepository,
 		orgResponsibleRepository: orgResponsibleRepository,
+		scoringRepository:        scoringRepository,
 	}
 }
 
 type GetBidsOfTenderDTO struct {
-	TenderID string
-	Username string
-	Limit    *int
-	Offset   *int
+	TenderID       string
+	Username       string
+	Limit          *int
+	Offset         *int
+	Statuses       []string
+	Query          *string
+	CreatedFrom    *string
+	CreatedTo      *string
+	UpdatedSince   *string
+	PriceCurrency  *string
+	MinPrice       *string
+	MaxPrice       *string
+	NormalizePrice bool
+	SortBy         *string
+	SortOrder      *string
+	Cursor         *string
+	IncludeTotal   bool
 }
 
-func (uc GetBidsOfTenderUseCase) Execute(dto GetBidsOfTenderDTO) ([]domain.Bid, error) {
+func (uc GetBidsOfTenderUseCase) Execute(dto GetBidsOfTenderDTO) (*repositories.Page[repositories.BidSearchResult], error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -70,17 +86,145 @@ func (uc GetBidsOfTenderUseCase) Execute(dto GetBidsOfTenderDTO) ([]domain.Bid,
 		return nil, errors.Wrap(domain.ErrNoPermission, "organization does not belong to tender")
 	}
 
-	// Получение списка Bid
+	// Разбор фильтров
+	statuses := make([]domain.BidStatus, 0, len(dto.Statuses))
+	for _, s := range dto.Statuses {
+		status, err := domain.NewBidStatus(s)
+		if err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, status)
+	}
+
+	timeFilter, err := repositories.NewTimeFilter(dto.CreatedFrom, dto.CreatedTo, dto.UpdatedSince)
+	if err != nil {
+		return nil, err
+	}
+
+	priceFilter, err := repositories.NewAmountFilter(dto.PriceCurrency, dto.MinPrice, dto.MaxPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	search, err := repositories.NewSearchQuery(dto.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	sort, err := repositories.NewBidSort(dto.SortBy, dto.SortOrder, search != nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Сортировка по оценке имеет смысл только для тендера с критериями оценки
+	var criteria domain.ScoringCriteria
+	if sort.Field == repositories.SortByScore {
+		criteria, err = uc.scoringRepository.GetCriteria(ctx, tender.ID)
+		if err != nil {
+			return nil, err
+		}
+		if len(criteria) == 0 {
+			return nil, errors.Wrap(domain.ErrValidation, "Tender has no scoring criteria")
+		}
+	}
+
+	cursor, err := repositories.NewCursor(dto.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	if cursor != nil && cursor.Sort != sort {
+		return nil, errors.Wrap(domain.ErrValidation, "cursor was issued for a different sort")
+	}
+
+	// Получение списка Bid, запрашивается на один элемент больше для определения следующей страницы
 	limit := repositories.NewLimit(dto.Limit)
 	offset := repositories.NewOffset(dto.Offset)
-	bids, err := uc.bidRepository.GetList(ctx, repositories.GetBidListDTO{
-		TenderID: &tender.ID,
-		Limit:    &limit,
-		Offset:   &offset,
-	})
+	fetch := limit + 1
+	listDTO := repositories.GetBidListDTO{
+		TimeFilter: timeFilter,
+		Price:      priceFilter,
+		TenderID:   &tender.ID,
+		Statuses:   statuses,
+		Query:      search,
+		Sort:       &sort,
+		After:      cursor,
+		Limit:      &fetch,
+	}
+	if cursor == nil {
+		listDTO.Offset = &offset
+	}
+
+	var bids []repositories.BidSearchResult
+
+	if search != nil {
+		bids, err = uc.bidRepository.Search(ctx, listDTO)
+	} else {
+		var list []domain.Bid
+		list, err = uc.bidRepository.GetList(ctx, listDTO)
+		bids = repositories.BidSearchResults(list)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	return bids, nil
+	// Сортировка по приведенной цене требует ее и для курсора следующей страницы
+	if dto.NormalizePrice || sort.Field == repositories.SortByNormalizedPrice {
+		if err := normalizePrices(ctx, uc.bidRepository, bids); err != nil {
+			return nil, err
+		}
+	}
+
+	// Оценки считаются только для полученной страницы
+	if sort.Field == repositories.SortByScore {
+		if err := uc.scoreBids(ctx, criteria, bids); err != nil {
+			return nil, err
+		}
+	}
+
+	page := repositories.NewPage(bids, limit, func(b repositories.BidSearchResult) repositories.Cursor {
+		return b.Cursor(sort)
+	})
+
+	// Подсчет общего кол-ва Bid
+	if dto.IncludeTotal {
+		total, err := uc.bidRepository.Count(ctx, listDTO)
+		if err != nil {
+			return nil, err
+		}
+		page.Total = &total
+	}
+
+	return &page, nil
+}
+
+// scoreBids заполняет взвешенные оценки и средние оценки по критериям для предложений страницы
+func (uc GetBidsOfTenderUseCase) scoreBids(ctx context.Context, criteria domain.ScoringCriteria, bids []repositories.BidSearchResult) error {
+	if len(bids) == 0 {
+		return nil
+	}
+
+	ids := make([]domain.ID, 0, len(bids))
+	for _, b := range bids {
+		ids = append(ids, b.ID)
+	}
+
+	weighted, err := uc.bidRepository.GetWeightedScores(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	scores, err := uc.scoringRepository.GetScores(ctx, repositories.GetBidScoresDTO{
+		BidIDs: ids,
+	})
+	if err != nil {
+		return err
+	}
+
+	for i := range bids {
+		score := weighted[bids[i].ID]
+		bids[i].Score = &score
+		bids[i].Criteria = domain.CriterionScores(criteria, bids[i].ID, scores)
+	}
+
+	return nil
 }
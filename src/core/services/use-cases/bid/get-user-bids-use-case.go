@@ -2,6 +2,7 @@ package use_cases
 
 import (
 	"context"
+	"github.com/pkg/errors"
 	"time"
 	"tms/src/core/domain"
 	"tms/src/core/services/repositories"
@@ -23,12 +24,27 @@ func NewGetUserBidsUseCase(
 }
 
 type GetUserBidsDTO struct {
-	Limit    *int
-	Offset   *int
-	Username string
+	Limit           *int
+	Offset          *int
+	Username        string
+	Statuses        []string
+	ServiceTypes    []string
+	OrganizationIDs []string
+	Query           *string
+	CreatedFrom     *string
+	CreatedTo       *string
+	UpdatedSince    *string
+	PriceCurrency   *string
+	MinPrice        *string
+	MaxPrice        *string
+	NormalizePrice  bool
+	SortBy          *string
+	SortOrder       *string
+	Cursor          *string
+	IncludeTotal    bool
 }
 
-func (uc GetUserBidsUseCase) Execute(dto GetUserBidsDTO) ([]domain.Bid, error) {
+func (uc GetUserBidsUseCase) Execute(dto GetUserBidsDTO) (*repositories.Page[repositories.BidSearchResult], error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -40,17 +56,114 @@ func (uc GetUserBidsUseCase) Execute(dto GetUserBidsDTO) ([]domain.Bid, error) {
 		return nil, err
 	}
 
-	// Получение списка Bid
+	// Разбор фильтров
+	statuses := make([]domain.BidStatus, 0, len(dto.Statuses))
+	for _, s := range dto.Statuses {
+		status, err := domain.NewBidStatus(s)
+		if err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, status)
+	}
+
+	serviceTypes := make([]domain.TenderServiceType, 0, len(dto.ServiceTypes))
+	for _, s := range dto.ServiceTypes {
+		st, err := domain.NewTenderServiceType(s)
+		if err != nil {
+			return nil, err
+		}
+		serviceTypes = append(serviceTypes, st)
+	}
+
+	organizationIDs := make([]domain.ID, 0, len(dto.OrganizationIDs))
+	for _, id := range dto.OrganizationIDs {
+		organizationIDs = append(organizationIDs, domain.ID(id))
+	}
+
+	timeFilter, err := repositories.NewTimeFilter(dto.CreatedFrom, dto.CreatedTo, dto.UpdatedSince)
+	if err != nil {
+		return nil, err
+	}
+
+	priceFilter, err := repositories.NewAmountFilter(dto.PriceCurrency, dto.MinPrice, dto.MaxPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	search, err := repositories.NewSearchQuery(dto.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	sort, err := repositories.NewBidSort(dto.SortBy, dto.SortOrder, search != nil)
+	if err != nil {
+		return nil, err
+	}
+	// Оценки видны только организации тендера, поэтому автор не может сортировать по ним
+	if sort.Field == repositories.SortByScore {
+		return nil, errors.Wrap(domain.ErrValidation, "user bids cannot be sorted by score")
+	}
+
+	cursor, err := repositories.NewCursor(dto.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	if cursor != nil && cursor.Sort != sort {
+		return nil, errors.Wrap(domain.ErrValidation, "cursor was issued for a different sort")
+	}
+
+	// Получение списка Bid, запрашивается на один элемент больше для определения следующей страницы
 	limit := repositories.NewLimit(dto.Limit)
 	offset := repositories.NewOffset(dto.Offset)
-	bidList, err := uc.bidRepository.GetList(ctx, repositories.GetBidListDTO{
-		AuthorID: &employee.ID,
-		Limit:    &limit,
-		Offset:   &offset,
-	})
+	fetch := limit + 1
+	listDTO := repositories.GetBidListDTO{
+		TimeFilter:      timeFilter,
+		Price:           priceFilter,
+		AuthorID:        &employee.ID,
+		Statuses:        statuses,
+		ServiceTypes:    serviceTypes,
+		OrganizationIDs: organizationIDs,
+		Query:           search,
+		Sort:            &sort,
+		After:           cursor,
+		Limit:           &fetch,
+	}
+	if cursor == nil {
+		listDTO.Offset = &offset
+	}
+
+	var bidList []repositories.BidSearchResult
+
+	if search != nil {
+		bidList, err = uc.bidRepository.Search(ctx, listDTO)
+	} else {
+		var list []domain.Bid
+		list, err = uc.bidRepository.GetList(ctx, listDTO)
+		bidList = repositories.BidSearchResults(list)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	return bidList, nil
+	// Сортировка по приведенной цене требует ее и для курсора следующей страницы
+	if dto.NormalizePrice || sort.Field == repositories.SortByNormalizedPrice {
+		if err := normalizePrices(ctx, uc.bidRepository, bidList); err != nil {
+			return nil, err
+		}
+	}
+
+	page := repositories.NewPage(bidList, limit, func(b repositories.BidSearchResult) repositories.Cursor {
+		return b.Cursor(sort)
+	})
+
+	// Подсчет общего кол-ва Bid
+	if dto.IncludeTotal {
+		total, err := uc.bidRepository.Count(ctx, listDTO)
+		if err != nil {
+			return nil, err
+		}
+		page.Total = &total
+	}
+
+	return &page, nil
 }
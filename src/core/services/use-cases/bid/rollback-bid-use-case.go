@@ -4,21 +4,34 @@ import (
 	"context"
 	"time"
 	"tms/src/core/domain"
+	"tms/src/core/services/events"
 	"tms/src/core/services/repositories"
 )
 
 type RollbackBidUseCase struct {
-	employeeRepository repositories.EmployeeRepository
-	bidRepository      repositories.BidRepository
+	employeeRepository     repositories.EmployeeRepository
+	tenderRepository       repositories.TenderRepository
+	bidRepository          repositories.BidRepository
+	exchangeRateRepository repositories.ExchangeRateRepository
+	transactor             repositories.Transactor
+	dispatcher             events.Dispatcher
 }
 
 func NewRollbackBidUseCase(
 	employeeRepository repositories.EmployeeRepository,
+	tenderRepository repositories.TenderRepository,
 	bidRepository repositories.BidRepository,
+	exchangeRateRepository repositories.ExchangeRateRepository,
+	transactor repositories.Transactor,
+	dispatcher events.Dispatcher,
 ) RollbackBidUseCase {
 	return RollbackBidUseCase{
-		employeeRepository: employeeRepository,
-		bidRepository:      bidRepository,
+		employeeRepository:     employeeRepository,
+		tenderRepository:       tenderRepository,
+		bidRepository:          bidRepository,
+		exchangeRateRepository: exchangeRateRepository,
+		transactor:             transactor,
+		dispatcher:             dispatcher,
 	}
 }
 
@@ -26,10 +39,18 @@ type RollbackBidDTO struct {
 	BidID    string
 	Version  int
 	Username string
+	// DryRun вычислить результат отката без сохранения
+	DryRun bool
 }
 
-func (uc RollbackBidUseCase) Execute(dto RollbackBidDTO) (*domain.Bid, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+// RollbackBidResult Результат отката и изменения относительно версии до отката
+type RollbackBidResult struct {
+	Bid  *domain.Bid        `json:"bid"`
+	Diff domain.VersionDiff `json:"diff"`
+}
+
+func (uc RollbackBidUseCase) Execute(ctx context.Context, dto RollbackBidDTO) (*RollbackBidResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	employee, err := uc.employeeRepository.Get(ctx, repositories.GetEmployeeDTO{
@@ -48,13 +69,52 @@ func (uc RollbackBidUseCase) Execute(dto RollbackBidDTO) (*domain.Bid, error) {
 		return nil, err
 	}
 
-	if err := bid.Rollback(dto.Version); err != nil {
+	// После окончания приема предложений их нельзя изменить
+	tender, err := uc.tenderRepository.Get(ctx, repositories.GetTenderDTO{
+		ID: bid.TenderID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tender.AcceptsBids(time.Now()); err != nil {
+		return nil, err
+	}
+
+	before := bid.CurrentVersion()
+
+	if err := bid.Rollback(employee.ID, dto.Version); err != nil {
+		return nil, err
+	}
+
+	// Цена версии могла превышать текущий бюджет тендера
+	rate, err := priceRate(ctx, uc.exchangeRateRepository, *tender, bid.Price, bid.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tender.CheckPrice(bid.Price, rate); err != nil {
 		return nil, err
 	}
 
-	if err := uc.bidRepository.Save(ctx, *bid); err != nil {
+	result := &RollbackBidResult{
+		Bid:  bid,
+		Diff: before.Diff(bid.CurrentVersion()),
+	}
+
+	if dto.DryRun {
+		return result, nil
+	}
+
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.bidRepository.Save(ctx, *bid); err != nil {
+			return err
+		}
+		return uc.dispatcher.Dispatch(ctx, bid.PullEvents()...)
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	return bid, nil
+	return result, nil
 }
@@ -0,0 +1,111 @@
+package use_cases
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/events"
+	"tms/src/core/services/repositories"
+)
+
+type SubmitBidScoresDTO struct {
+	BidID    string
+	Username string
+	// Scores оценки по названиям критериев
+	Scores map[string]int
+}
+
+type SubmitBidScoresUseCase struct {
+	employeeRepository       repositories.EmployeeRepository
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository
+	tenderRepository         repositories.TenderRepository
+	bidRepository            repositories.BidRepository
+	scoringRepository        repositories.ScoringRepository
+	transactor               repositories.Transactor
+	dispatcher               events.Dispatcher
+}
+
+// Execute сохраняет оценки ответственного и возвращает все его оценки предложения
+func (uc SubmitBidScoresUseCase) Execute(ctx context.Context, dto SubmitBidScoresDTO) ([]domain.BidScore, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	employee, err := uc.employeeRepository.Get(ctx, repositories.GetEmployeeDTO{
+		Username: &dto.Username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	orgResponsible, err := uc.orgResponsibleRepository.Get(ctx, repositories.GetOrganizationResponsibleDTO{
+		EmployeeID: employee.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bid, err := uc.bidRepository.Get(ctx, repositories.GetBidDTO{
+		ID: domain.ID(dto.BidID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tender, err := uc.tenderRepository.Get(ctx, repositories.GetTenderDTO{
+		ID: bid.TenderID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Оценивать предложения могут только ответственные за организацию тендера
+	if orgResponsible.OrganizationID != tender.OrganizationID {
+		return nil, errors.Wrap(domain.ErrNoPermission, "organization does not belong to tender")
+	}
+
+	criteria, err := uc.scoringRepository.GetCriteria(ctx, tender.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	scores, err := domain.NewBidScores(criteria, *bid, employee.ID, dto.Scores)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.scoringRepository.SaveScores(ctx, scores); err != nil {
+			return err
+		}
+		return uc.dispatcher.Dispatch(ctx, domain.NewBidScored(*tender, *bid, employee.ID, scores))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.scoringRepository.GetScores(ctx, repositories.GetBidScoresDTO{
+		BidID:       &bid.ID,
+		EvaluatorID: &employee.ID,
+	})
+}
+
+func NewSubmitBidScoresUseCase(
+	employeeRepository repositories.EmployeeRepository,
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository,
+	tenderRepository repositories.TenderRepository,
+	bidRepository repositories.BidRepository,
+	scoringRepository repositories.ScoringRepository,
+	transactor repositories.Transactor,
+	dispatcher events.Dispatcher,
+) SubmitBidScoresUseCase {
+	return SubmitBidScoresUseCase{
+		employeeRepository:       employeeRepository,
+		orgResponsibleRepository: orgResponsibleRepository,
+		tenderRepository:         tenderRepository,
+		bidRepository:            bidRepository,
+		scoringRepository:        scoringRepository,
+		transactor:               transactor,
+		dispatcher:               dispatcher,
+	}
+}
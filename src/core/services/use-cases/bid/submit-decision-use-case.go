@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 	"tms/src/core/domain"
+	"tms/src/core/services/events"
 	"tms/src/core/services/repositories"
 )
 
@@ -13,6 +14,8 @@ type SubmitDecisionUseCase struct {
 	bidRepository      repositories.BidRepository
 	tenderRepository   repositories.TenderRepository
 	decisionRepository repositories.DecisionRepository
+	transactor         repositories.Transactor
+	dispatcher         events.Dispatcher
 }
 
 func NewSubmitDecisionUseCase(
@@ -21,6 +24,8 @@ func NewSubmitDecisionUseCase(
 	bidRepository repositories.BidRepository,
 	tenderRepository repositories.TenderRepository,
 	decisionRepository repositories.DecisionRepository,
+	transactor repositories.Transactor,
+	dispatcher events.Dispatcher,
 ) SubmitDecisionUseCase {
 	return SubmitDecisionUseCase{
 		employeeRepository: employeeRepository,
@@ -28,6 +33,8 @@ func NewSubmitDecisionUseCase(
 		bidRepository:      bidRepository,
 		tenderRepository:   tenderRepository,
 		decisionRepository: decisionRepository,
+		transactor:         transactor,
+		dispatcher:         dispatcher,
 	}
 }
 
@@ -35,10 +42,11 @@ type SubmitDecisionDTO struct {
 	BidID    string
 	Decision string
 	Username string
+	Reason   *string
 }
 
-func (uc SubmitDecisionUseCase) Execute(dto SubmitDecisionDTO) (*domain.Bid, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func (uc SubmitDecisionUseCase) Execute(ctx context.Context, dto SubmitDecisionDTO) (*domain.Bid, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	tenderOwnerEmployee, err := uc.employeeRepository.Get(ctx, repositories.GetEmployeeDTO{
@@ -89,17 +97,32 @@ func (uc SubmitDecisionUseCase) Execute(dto SubmitDecisionDTO) (*domain.Bid, err
 		return nil, err
 	}
 
-	domain.MakeFinalDecision(len(tenderQuorum), len(decisions), *decision, *tenderOwnerOrgResp, tender, bid)
-
-	if err := uc.tenderRepository.Save(ctx, *tender); err != nil {
+	reason, err := domain.NewStatusChangeReason(dto.Reason)
+	if err != nil {
 		return nil, err
 	}
 
-	if err := uc.bidRepository.Save(ctx, *bid); err != nil {
-		return nil, err
-	}
+	domain.MakeFinalDecision(len(tenderQuorum), len(decisions), *decision, *tenderOwnerOrgResp, tender, bid, reason)
+
+	// Tender, Bid, Decision и их события сохраняются в одной транзакции, чтобы события не терялись при сбое
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.tenderRepository.Save(ctx, *tender); err != nil {
+			return err
+		}
 
-	if err := uc.decisionRepository.Save(ctx, *decision); err != nil {
+		if err := uc.bidRepository.Save(ctx, *bid); err != nil {
+			return err
+		}
+
+		if err := uc.decisionRepository.Save(ctx, *decision); err != nil {
+			return err
+		}
+
+		pending := append(decision.PullEvents(), bid.PullEvents()...)
+		pending = append(pending, tender.PullEvents()...)
+		return uc.dispatcher.Dispatch(ctx, pending...)
+	})
+	if err != nil {
 		return nil, err
 	}
 
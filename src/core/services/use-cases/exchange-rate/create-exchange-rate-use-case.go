@@ -0,0 +1,66 @@
+package use_cases
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+type CreateExchangeRateDTO struct {
+	Username      string `json:"username"`
+	From          string `json:"from"`
+	To            string `json:"to"`
+	Rate          string `json:"rate"`
+	EffectiveDate string `json:"effectiveDate"`
+}
+
+type CreateExchangeRateUseCase struct {
+	employeeRepository       repositories.EmployeeRepository
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository
+	exchangeRateRepository   repositories.ExchangeRateRepository
+}
+
+func (uc CreateExchangeRateUseCase) Execute(ctx context.Context, dto CreateExchangeRateDTO) (*domain.ExchangeRate, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	orgResponsible, err := organizationResponsible(ctx, uc.employeeRepository, uc.orgResponsibleRepository, dto.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	rate, err := domain.NewExchangeRate(dto.From, dto.To, dto.Rate, dto.EffectiveDate, *orgResponsible)
+	if err != nil {
+		return nil, err
+	}
+
+	// На одну дату у пары может быть только один курс организации
+	existing, err := uc.exchangeRateRepository.Find(ctx, rate.OrganizationID, rate.From, rate.To, rate.EffectiveDate)
+	if err != nil && !errors.Is(errors.Cause(err), domain.ErrNotFound) {
+		return nil, err
+	}
+	if existing != nil && existing.EffectiveDate.Equal(rate.EffectiveDate) {
+		return nil, errors.Wrapf(domain.ErrAlreadyExist, "exchange rate from %s to %s on %s already exists",
+			rate.From, rate.To, dto.EffectiveDate)
+	}
+
+	if err := uc.exchangeRateRepository.Save(ctx, *rate); err != nil {
+		return nil, err
+	}
+
+	return rate, nil
+}
+
+func NewCreateExchangeRateUseCase(
+	employeeRepository repositories.EmployeeRepository,
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository,
+	exchangeRateRepository repositories.ExchangeRateRepository,
+) CreateExchangeRateUseCase {
+	return CreateExchangeRateUseCase{
+		employeeRepository:       employeeRepository,
+		orgResponsibleRepository: orgResponsibleRepository,
+		exchangeRateRepository:   exchangeRateRepository,
+	}
+}
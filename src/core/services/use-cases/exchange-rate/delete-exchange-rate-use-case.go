@@ -0,0 +1,57 @@
+package use_cases
+
+import (
+	"context"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+type DeleteExchangeRateDTO struct {
+	ExchangeRateID string
+	Username       string
+}
+
+type DeleteExchangeRateUseCase struct {
+	employeeRepository       repositories.EmployeeRepository
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository
+	exchangeRateRepository   repositories.ExchangeRateRepository
+}
+
+// Execute удаляет курс. Для дат, на которые он действовал, снова начинает действовать предыдущий курс пары
+func (uc DeleteExchangeRateUseCase) Execute(ctx context.Context, dto DeleteExchangeRateDTO) (*domain.ExchangeRate, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	orgResponsible, err := organizationResponsible(ctx, uc.employeeRepository, uc.orgResponsibleRepository, dto.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	rate, err := uc.exchangeRateRepository.Get(ctx, domain.ID(dto.ExchangeRateID))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rate.CheckAccess(*orgResponsible); err != nil {
+		return nil, err
+	}
+
+	if err := uc.exchangeRateRepository.Delete(ctx, rate.ID); err != nil {
+		return nil, err
+	}
+
+	return rate, nil
+}
+
+func NewDeleteExchangeRateUseCase(
+	employeeRepository repositories.EmployeeRepository,
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository,
+	exchangeRateRepository repositories.ExchangeRateRepository,
+) DeleteExchangeRateUseCase {
+	return DeleteExchangeRateUseCase{
+		employeeRepository:       employeeRepository,
+		orgResponsibleRepository: orgResponsibleRepository,
+		exchangeRateRepository:   exchangeRateRepository,
+	}
+}
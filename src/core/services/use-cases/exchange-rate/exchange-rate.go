@@ -0,0 +1,36 @@
+package use_cases
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+// organizationResponsible возвращает ответственного за организацию сотрудника с именем username.
+// Курсы организации изменяют только ее ответственные
+func organizationResponsible(
+	ctx context.Context,
+	employeeRepository repositories.EmployeeRepository,
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository,
+	username string,
+) (*domain.OrganizationResponsible, error) {
+	employee, err := employeeRepository.Get(ctx, repositories.GetEmployeeDTO{
+		Username: &username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	responsible, err := orgResponsibleRepository.Get(ctx, repositories.GetOrganizationResponsibleDTO{
+		EmployeeID: employee.ID,
+	})
+	if err != nil {
+		if errors.Is(errors.Cause(err), domain.ErrNotFound) {
+			return nil, errors.Wrap(domain.ErrNoPermission, "employee is not organization responsible")
+		}
+		return nil, err
+	}
+
+	return responsible, nil
+}
@@ -0,0 +1,81 @@
+package use_cases
+
+import (
+	"context"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+type GetExchangeRatesDTO struct {
+	Username       string  `json:"username"`
+	OrganizationID string  `json:"organizationId"`
+	From           *string `json:"from"`
+	To             *string `json:"to"`
+	Date           *string `json:"date"`
+	Limit          *int    `json:"limit"`
+	Offset         *int    `json:"offset"`
+}
+
+type GetExchangeRatesUseCase struct {
+	employeeRepository     repositories.EmployeeRepository
+	exchangeRateRepository repositories.ExchangeRateRepository
+}
+
+// Execute возвращает курсы валют организации. Просматривать курсы может любой сотрудник,
+// так как по ним проверяются цены предложений на тендеры организации
+func (uc GetExchangeRatesUseCase) Execute(dto GetExchangeRatesDTO) ([]domain.ExchangeRate, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := uc.employeeRepository.Get(ctx, repositories.GetEmployeeDTO{
+		Username: &dto.Username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	limit := repositories.NewLimit(dto.Limit)
+	offset := repositories.NewOffset(dto.Offset)
+	listDTO := repositories.GetExchangeRateListDTO{
+		OrganizationID: domain.ID(dto.OrganizationID),
+		Limit:          &limit,
+		Offset:         &offset,
+	}
+
+	if dto.From != nil {
+		from, err := domain.NewCurrency(*dto.From)
+		if err != nil {
+			return nil, err
+		}
+		listDTO.From = &from
+	}
+
+	if dto.To != nil {
+		to, err := domain.NewCurrency(*dto.To)
+		if err != nil {
+			return nil, err
+		}
+		listDTO.To = &to
+	}
+
+	if dto.Date != nil {
+		date, err := domain.ParseDate(*dto.Date)
+		if err != nil {
+			return nil, err
+		}
+		listDTO.Date = &date
+	}
+
+	return uc.exchangeRateRepository.GetList(ctx, listDTO)
+}
+
+func NewGetExchangeRatesUseCase(
+	employeeRepository repositories.EmployeeRepository,
+	exchangeRateRepository repositories.ExchangeRateRepository,
+) GetExchangeRatesUseCase {
+	return GetExchangeRatesUseCase{
+		employeeRepository:     employeeRepository,
+		exchangeRateRepository: exchangeRateRepository,
+	}
+}
@@ -0,0 +1,78 @@
+package use_cases
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"slices"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+	"tms/src/pkg/jobs"
+)
+
+type GetJobsDTO struct {
+	Username string  `json:"username"`
+	Status   *string `json:"status"`
+	Limit    *int    `json:"limit"`
+	Offset   *int    `json:"offset"`
+}
+
+type GetJobsUseCase struct {
+	employeeRepository repositories.EmployeeRepository
+	jobStore           jobs.Store
+	admins             []string
+}
+
+// Execute возвращает незавершенные фоновые задачи в порядке их запуска. Задачи относятся ко всему сервису
+// и содержат параметры и ошибки любых организаций, поэтому доступны только администраторам из admins
+func (uc GetJobsUseCase) Execute(dto GetJobsDTO) ([]jobs.Job, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	employee, err := uc.employeeRepository.Get(ctx, repositories.GetEmployeeDTO{
+		Username: &dto.Username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(uc.admins, employee.Username) {
+		return nil, errors.Wrap(domain.ErrNoPermission, "employee is not an administrator")
+	}
+
+	opts := jobs.ListOptions{
+		Limit:  int(repositories.NewLimit(dto.Limit)),
+		Offset: int(repositories.NewOffset(dto.Offset)),
+	}
+
+	if dto.Status != nil {
+		status, err := newJobStatus(*dto.Status)
+		if err != nil {
+			return nil, err
+		}
+		opts.Statuses = []jobs.Status{status}
+	}
+
+	return uc.jobStore.List(ctx, opts)
+}
+
+func newJobStatus(s string) (jobs.Status, error) {
+	switch status := jobs.Status(s); status {
+	case jobs.PendingStatus, jobs.RunningStatus, jobs.FailedStatus:
+		return status, nil
+	}
+
+	return "", errors.Wrapf(domain.ErrValidation, "invalid job status: %s", s)
+}
+
+func NewGetJobsUseCase(
+	employeeRepository repositories.EmployeeRepository,
+	jobStore jobs.Store,
+	admins []string,
+) GetJobsUseCase {
+	return GetJobsUseCase{
+		employeeRepository: employeeRepository,
+		jobStore:           jobStore,
+		admins:             admins,
+	}
+}
@@ -0,0 +1,57 @@
+package use_cases
+
+import (
+	"context"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+type EditNotificationPreferencesDTO struct {
+	Username     string
+	EmailEnabled *bool
+	Language     *string
+}
+
+type EditNotificationPreferencesUseCase struct {
+	employeeRepository   repositories.EmployeeRepository
+	preferenceRepository repositories.NotificationPreferenceRepository
+}
+
+func (uc EditNotificationPreferencesUseCase) Execute(ctx context.Context, dto EditNotificationPreferencesDTO) (*domain.NotificationPreferences, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	// Проверка существования Employee
+	employee, err := uc.employeeRepository.Get(ctx, repositories.GetEmployeeDTO{
+		Username: &dto.Username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	preferences, err := preferencesOf(ctx, uc.preferenceRepository, employee.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := preferences.Edit(dto.EmailEnabled, dto.Language); err != nil {
+		return nil, err
+	}
+
+	if err := uc.preferenceRepository.Save(ctx, *preferences); err != nil {
+		return nil, err
+	}
+
+	return preferences, nil
+}
+
+func NewEditNotificationPreferencesUseCase(
+	employeeRepository repositories.EmployeeRepository,
+	preferenceRepository repositories.NotificationPreferenceRepository,
+) EditNotificationPreferencesUseCase {
+	return EditNotificationPreferencesUseCase{
+		employeeRepository:   employeeRepository,
+		preferenceRepository: preferenceRepository,
+	}
+}
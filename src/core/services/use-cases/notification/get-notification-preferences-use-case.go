@@ -0,0 +1,42 @@
+package use_cases
+
+import (
+	"context"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+type GetNotificationPreferencesDTO struct {
+	Username string `json:"username"`
+}
+
+type GetNotificationPreferencesUseCase struct {
+	employeeRepository   repositories.EmployeeRepository
+	preferenceRepository repositories.NotificationPreferenceRepository
+}
+
+func (uc GetNotificationPreferencesUseCase) Execute(dto GetNotificationPreferencesDTO) (*domain.NotificationPreferences, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Проверка существования Employee
+	employee, err := uc.employeeRepository.Get(ctx, repositories.GetEmployeeDTO{
+		Username: &dto.Username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return preferencesOf(ctx, uc.preferenceRepository, employee.ID)
+}
+
+func NewGetNotificationPreferencesUseCase(
+	employeeRepository repositories.EmployeeRepository,
+	preferenceRepository repositories.NotificationPreferenceRepository,
+) GetNotificationPreferencesUseCase {
+	return GetNotificationPreferencesUseCase{
+		employeeRepository:   employeeRepository,
+		preferenceRepository: preferenceRepository,
+	}
+}
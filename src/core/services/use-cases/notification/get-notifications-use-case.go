@@ -0,0 +1,53 @@
+package use_cases
+
+import (
+	"context"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+type GetNotificationsDTO struct {
+	Username string `json:"username"`
+	Unread   bool   `json:"unread"`
+	Limit    *int   `json:"limit"`
+	Offset   *int   `json:"offset"`
+}
+
+type GetNotificationsUseCase struct {
+	employeeRepository     repositories.EmployeeRepository
+	notificationRepository repositories.NotificationRepository
+}
+
+func (uc GetNotificationsUseCase) Execute(dto GetNotificationsDTO) ([]domain.Notification, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Проверка существования Employee
+	employee, err := uc.employeeRepository.Get(ctx, repositories.GetEmployeeDTO{
+		Username: &dto.Username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	limit := repositories.NewLimit(dto.Limit)
+	offset := repositories.NewOffset(dto.Offset)
+
+	return uc.notificationRepository.GetList(ctx, repositories.GetNotificationsListDTO{
+		RecipientID: employee.ID,
+		Unread:      dto.Unread,
+		Limit:       &limit,
+		Offset:      &offset,
+	})
+}
+
+func NewGetNotificationsUseCase(
+	employeeRepository repositories.EmployeeRepository,
+	notificationRepository repositories.NotificationRepository,
+) GetNotificationsUseCase {
+	return GetNotificationsUseCase{
+		employeeRepository:     employeeRepository,
+		notificationRepository: notificationRepository,
+	}
+}
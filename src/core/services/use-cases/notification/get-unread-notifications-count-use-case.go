@@ -0,0 +1,51 @@
+package use_cases
+
+import (
+	"context"
+	"time"
+	"tms/src/core/services/repositories"
+)
+
+// NotificationsCount Кол-во уведомлений
+type NotificationsCount struct {
+	Count int `json:"count"`
+}
+
+type GetUnreadNotificationsCountDTO struct {
+	Username string `json:"username"`
+}
+
+type GetUnreadNotificationsCountUseCase struct {
+	employeeRepository     repositories.EmployeeRepository
+	notificationRepository repositories.NotificationRepository
+}
+
+func (uc GetUnreadNotificationsCountUseCase) Execute(dto GetUnreadNotificationsCountDTO) (*NotificationsCount, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Проверка существования Employee
+	employee, err := uc.employeeRepository.Get(ctx, repositories.GetEmployeeDTO{
+		Username: &dto.Username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := uc.notificationRepository.CountUnread(ctx, employee.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NotificationsCount{Count: count}, nil
+}
+
+func NewGetUnreadNotificationsCountUseCase(
+	employeeRepository repositories.EmployeeRepository,
+	notificationRepository repositories.NotificationRepository,
+) GetUnreadNotificationsCountUseCase {
+	return GetUnreadNotificationsCountUseCase{
+		employeeRepository:     employeeRepository,
+		notificationRepository: notificationRepository,
+	}
+}
@@ -0,0 +1,22 @@
+package use_cases
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+// preferencesOf возвращает настройки сотрудника или настройки по умолчанию, если он их не менял
+func preferencesOf(ctx context.Context, preferenceRepository repositories.NotificationPreferenceRepository, employeeID domain.ID) (*domain.NotificationPreferences, error) {
+	preferences, err := preferenceRepository.Get(ctx, employeeID)
+	if err != nil {
+		if !errors.Is(errors.Cause(err), domain.ErrNotFound) {
+			return nil, err
+		}
+		defaults := domain.DefaultNotificationPreferences(employeeID)
+		return &defaults, nil
+	}
+
+	return preferences, nil
+}
@@ -0,0 +1,47 @@
+package use_cases
+
+import (
+	"context"
+	"time"
+	"tms/src/core/services/repositories"
+)
+
+type ReadAllNotificationsDTO struct {
+	Username string `json:"username"`
+}
+
+type ReadAllNotificationsUseCase struct {
+	employeeRepository     repositories.EmployeeRepository
+	notificationRepository repositories.NotificationRepository
+}
+
+// Execute отмечает прочитанными все уведомления сотрудника и возвращает кол-во отмеченных
+func (uc ReadAllNotificationsUseCase) Execute(ctx context.Context, dto ReadAllNotificationsDTO) (*NotificationsCount, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	// Проверка существования Employee
+	employee, err := uc.employeeRepository.Get(ctx, repositories.GetEmployeeDTO{
+		Username: &dto.Username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := uc.notificationRepository.ReadAll(ctx, employee.ID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return &NotificationsCount{Count: count}, nil
+}
+
+func NewReadAllNotificationsUseCase(
+	employeeRepository repositories.EmployeeRepository,
+	notificationRepository repositories.NotificationRepository,
+) ReadAllNotificationsUseCase {
+	return ReadAllNotificationsUseCase{
+		employeeRepository:     employeeRepository,
+		notificationRepository: notificationRepository,
+	}
+}
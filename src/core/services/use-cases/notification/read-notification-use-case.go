@@ -0,0 +1,56 @@
+package use_cases
+
+import (
+	"context"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+type ReadNotificationDTO struct {
+	NotificationID string `json:"notificationId"`
+	Username       string `json:"username"`
+}
+
+type ReadNotificationUseCase struct {
+	employeeRepository     repositories.EmployeeRepository
+	notificationRepository repositories.NotificationRepository
+}
+
+func (uc ReadNotificationUseCase) Execute(ctx context.Context, dto ReadNotificationDTO) (*domain.Notification, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	// Проверка существования Employee
+	employee, err := uc.employeeRepository.Get(ctx, repositories.GetEmployeeDTO{
+		Username: &dto.Username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	notification, err := uc.notificationRepository.Get(ctx, domain.ID(dto.NotificationID))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := notification.Read(employee.ID); err != nil {
+		return nil, err
+	}
+
+	if err := uc.notificationRepository.Save(ctx, *notification); err != nil {
+		return nil, err
+	}
+
+	return notification, nil
+}
+
+func NewReadNotificationUseCase(
+	employeeRepository repositories.EmployeeRepository,
+	notificationRepository repositories.NotificationRepository,
+) ReadNotificationUseCase {
+	return ReadNotificationUseCase{
+		employeeRepository:     employeeRepository,
+		notificationRepository: notificationRepository,
+	}
+}
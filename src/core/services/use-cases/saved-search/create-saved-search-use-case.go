@@ -0,0 +1,60 @@
+package use_cases
+
+import (
+	"context"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+type CreateSavedSearchDTO struct {
+	Username        string   `json:"username"`
+	Name            string   `json:"name"`
+	ServiceTypes    []string `json:"serviceTypes"`
+	OrganizationIDs []string `json:"organizationIds"`
+	Query           *string  `json:"query"`
+}
+
+type CreateSavedSearchUseCase struct {
+	employeeRepository    repositories.EmployeeRepository
+	savedSearchRepository repositories.SavedSearchRepository
+}
+
+func (uc CreateSavedSearchUseCase) Execute(ctx context.Context, dto CreateSavedSearchDTO) (*domain.SavedSearch, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	// Проверка существования Employee
+	employee, err := uc.employeeRepository.Get(ctx, repositories.GetEmployeeDTO{
+		Username: &dto.Username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := repositories.NewSearchQuery(dto.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	search, err := domain.NewSavedSearch(employee.ID, dto.Name, dto.ServiceTypes, dto.OrganizationIDs, query)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.savedSearchRepository.Save(ctx, *search); err != nil {
+		return nil, err
+	}
+
+	return search, nil
+}
+
+func NewCreateSavedSearchUseCase(
+	employeeRepository repositories.EmployeeRepository,
+	savedSearchRepository repositories.SavedSearchRepository,
+) CreateSavedSearchUseCase {
+	return CreateSavedSearchUseCase{
+		employeeRepository:    employeeRepository,
+		savedSearchRepository: savedSearchRepository,
+	}
+}
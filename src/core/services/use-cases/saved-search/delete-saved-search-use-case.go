@@ -0,0 +1,52 @@
+package use_cases
+
+import (
+	"context"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+type DeleteSavedSearchDTO struct {
+	SavedSearchID string
+	Username      string
+}
+
+type DeleteSavedSearchUseCase struct {
+	employeeRepository    repositories.EmployeeRepository
+	savedSearchRepository repositories.SavedSearchRepository
+}
+
+func (uc DeleteSavedSearchUseCase) Execute(ctx context.Context, dto DeleteSavedSearchDTO) (*domain.SavedSearch, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	// Проверка существования Employee
+	employee, err := uc.employeeRepository.Get(ctx, repositories.GetEmployeeDTO{
+		Username: &dto.Username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	search, err := accessibleSavedSearch(ctx, uc.savedSearchRepository, employee.ID, dto.SavedSearchID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.savedSearchRepository.Delete(ctx, search.ID); err != nil {
+		return nil, err
+	}
+
+	return search, nil
+}
+
+func NewDeleteSavedSearchUseCase(
+	employeeRepository repositories.EmployeeRepository,
+	savedSearchRepository repositories.SavedSearchRepository,
+) DeleteSavedSearchUseCase {
+	return DeleteSavedSearchUseCase{
+		employeeRepository:    employeeRepository,
+		savedSearchRepository: savedSearchRepository,
+	}
+}
@@ -0,0 +1,65 @@
+package use_cases
+
+import (
+	"context"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+type EditSavedSearchDTO struct {
+	SavedSearchID   string
+	Username        string
+	Name            *string
+	ServiceTypes    []string
+	OrganizationIDs []string
+	Query           *string
+}
+
+type EditSavedSearchUseCase struct {
+	employeeRepository    repositories.EmployeeRepository
+	savedSearchRepository repositories.SavedSearchRepository
+}
+
+func (uc EditSavedSearchUseCase) Execute(ctx context.Context, dto EditSavedSearchDTO) (*domain.SavedSearch, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	// Проверка существования Employee
+	employee, err := uc.employeeRepository.Get(ctx, repositories.GetEmployeeDTO{
+		Username: &dto.Username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	search, err := accessibleSavedSearch(ctx, uc.savedSearchRepository, employee.ID, dto.SavedSearchID)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := searchQuery(dto.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := search.Edit(employee.ID, dto.Name, dto.ServiceTypes, dto.OrganizationIDs, query); err != nil {
+		return nil, err
+	}
+
+	if err := uc.savedSearchRepository.Save(ctx, *search); err != nil {
+		return nil, err
+	}
+
+	return search, nil
+}
+
+func NewEditSavedSearchUseCase(
+	employeeRepository repositories.EmployeeRepository,
+	savedSearchRepository repositories.SavedSearchRepository,
+) EditSavedSearchUseCase {
+	return EditSavedSearchUseCase{
+		employeeRepository:    employeeRepository,
+		savedSearchRepository: savedSearchRepository,
+	}
+}
@@ -0,0 +1,42 @@
+package use_cases
+
+import (
+	"context"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+type GetSavedSearchesDTO struct {
+	Username string `json:"username"`
+}
+
+type GetSavedSearchesUseCase struct {
+	employeeRepository    repositories.EmployeeRepository
+	savedSearchRepository repositories.SavedSearchRepository
+}
+
+func (uc GetSavedSearchesUseCase) Execute(dto GetSavedSearchesDTO) ([]domain.SavedSearch, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Проверка существования Employee
+	employee, err := uc.employeeRepository.Get(ctx, repositories.GetEmployeeDTO{
+		Username: &dto.Username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.savedSearchRepository.GetList(ctx, employee.ID)
+}
+
+func NewGetSavedSearchesUseCase(
+	employeeRepository repositories.EmployeeRepository,
+	savedSearchRepository repositories.SavedSearchRepository,
+) GetSavedSearchesUseCase {
+	return GetSavedSearchesUseCase{
+		employeeRepository:    employeeRepository,
+		savedSearchRepository: savedSearchRepository,
+	}
+}
@@ -0,0 +1,39 @@
+package use_cases
+
+import (
+	"context"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+// accessibleSavedSearch возвращает сохраненный поиск, если он принадлежит сотруднику
+func accessibleSavedSearch(ctx context.Context, savedSearchRepository repositories.SavedSearchRepository, employeeID domain.ID, id string) (*domain.SavedSearch, error) {
+	search, err := savedSearchRepository.Get(ctx, domain.ID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := search.CheckAccess(employeeID); err != nil {
+		return nil, err
+	}
+
+	return search, nil
+}
+
+// searchQuery нормализует поисковый запрос. Для пустого запроса возвращается пустая строка, чтобы его можно было удалить
+func searchQuery(query *string) (*string, error) {
+	if query == nil {
+		return nil, nil
+	}
+
+	q, err := repositories.NewSearchQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	if q == nil {
+		empty := ""
+		return &empty, nil
+	}
+
+	return q, nil
+}
@@ -0,0 +1,75 @@
+package use_cases
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+	"tms/src/core/services/stream"
+)
+
+type SubscribeTenderDTO struct {
+	TenderID string
+	Username string
+}
+
+// SubscribeTenderUseCase подписывает ответственного за тендер на предложения и решения по ним в реальном времени
+type SubscribeTenderUseCase struct {
+	employeeRepository       repositories.EmployeeRepository
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository
+	tenderRepository         repositories.TenderRepository
+	hub                      *stream.Hub
+}
+
+func (uc SubscribeTenderUseCase) Execute(ctx context.Context, dto SubscribeTenderDTO) (*stream.Subscription, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	// Проверка существования Employee
+	employee, err := uc.employeeRepository.Get(ctx, repositories.GetEmployeeDTO{
+		Username: &dto.Username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Проверка существования OrgResponsible
+	orgResp, err := uc.orgResponsibleRepository.Get(ctx, repositories.GetOrganizationResponsibleDTO{
+		EmployeeID: employee.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Проверка существования Tender
+	tender, err := uc.tenderRepository.Get(ctx, repositories.GetTenderDTO{
+		ID: domain.ID(dto.TenderID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Проверка прав OrgReps
+	if orgResp.OrganizationID != tender.OrganizationID {
+		return nil, errors.Wrap(domain.ErrNoPermission, "organization does not belong to tender")
+	}
+
+	viewer := stream.Viewer{EmployeeID: employee.ID, OrganizationID: &orgResp.OrganizationID}
+
+	return uc.hub.SubscribeTender(viewer, tender.ID), nil
+}
+
+func NewSubscribeTenderUseCase(
+	employeeRepository repositories.EmployeeRepository,
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository,
+	tenderRepository repositories.TenderRepository,
+	hub *stream.Hub,
+) SubscribeTenderUseCase {
+	return SubscribeTenderUseCase{
+		employeeRepository:       employeeRepository,
+		orgResponsibleRepository: orgResponsibleRepository,
+		tenderRepository:         tenderRepository,
+		hub:                      hub,
+	}
+}
@@ -0,0 +1,71 @@
+package use_cases
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"strconv"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+	"tms/src/core/services/stream"
+)
+
+type SubscribeDTO struct {
+	Username string
+	// LastEventID значение заголовка Last-Event-ID, с которого восстанавливается поток
+	LastEventID *string
+}
+
+type SubscribeUseCase struct {
+	employeeRepository       repositories.EmployeeRepository
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository
+	hub                      *stream.Hub
+}
+
+func (uc SubscribeUseCase) Execute(ctx context.Context, dto SubscribeDTO) (*stream.Subscription, error) {
+	var lastSeq *int64
+	if dto.LastEventID != nil {
+		seq, err := strconv.ParseInt(*dto.LastEventID, 10, 64)
+		if err != nil || seq < 0 {
+			return nil, errors.Wrapf(domain.ErrValidation, "invalid Last-Event-ID - '%s'", *dto.LastEventID)
+		}
+		lastSeq = &seq
+	}
+
+	// Таймаут ограничивает только подготовку подписки, сам поток живет, пока открыт ctx
+	prepareCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	employee, err := uc.employeeRepository.Get(prepareCtx, repositories.GetEmployeeDTO{
+		Username: &dto.Username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	viewer := stream.Viewer{EmployeeID: employee.ID}
+
+	orgResponsible, err := uc.orgResponsibleRepository.Get(prepareCtx, repositories.GetOrganizationResponsibleDTO{
+		EmployeeID: employee.ID,
+	})
+	if err != nil && !errors.Is(errors.Cause(err), domain.ErrNotFound) {
+		return nil, err
+	}
+	if orgResponsible != nil {
+		viewer.OrganizationID = &orgResponsible.OrganizationID
+	}
+
+	return uc.hub.Subscribe(prepareCtx, viewer, lastSeq)
+}
+
+func NewSubscribeUseCase(
+	employeeRepository repositories.EmployeeRepository,
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository,
+	hub *stream.Hub,
+) SubscribeUseCase {
+	return SubscribeUseCase{
+		employeeRepository:       employeeRepository,
+		orgResponsibleRepository: orgResponsibleRepository,
+		hub:                      hub,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 	"tms/src/core/domain"
+	"tms/src/core/services/events"
 	"tms/src/core/services/repositories"
 )
 
@@ -11,17 +12,20 @@ type ChangeTenderStatusUseCase struct {
 	employeeRepository       repositories.EmployeeRepository
 	orgResponsibleRepository repositories.OrganizationResponsibleRepository
 	tenderRepository         repositories.TenderRepository
+	transactor               repositories.Transactor
+	dispatcher               events.Dispatcher
 }
 
 type ChangeTenderStatusDTO struct {
 	TenderID string
 	Status   string
 	Username string
+	Reason   *string
 }
 
-func (uc *ChangeTenderStatusUseCase) Execute(dto ChangeTenderStatusDTO) (*domain.Tender, error) {
+func (uc *ChangeTenderStatusUseCase) Execute(ctx context.Context, dto ChangeTenderStatusDTO) (*domain.Tender, error) {
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	employee, err := uc.employeeRepository.Get(ctx, repositories.GetEmployeeDTO{
@@ -50,11 +54,17 @@ func (uc *ChangeTenderStatusUseCase) Execute(dto ChangeTenderStatusDTO) (*domain
 		return nil, err
 	}
 
-	if err = tender.ChangeStatus(*orgResp, dto.Status); err != nil {
+	if err = tender.ChangeStatus(*orgResp, dto.Status, dto.Reason); err != nil {
 		return nil, err
 	}
 
-	if err = uc.tenderRepository.Save(ctx, *tender); err != nil {
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.tenderRepository.Save(ctx, *tender); err != nil {
+			return err
+		}
+		return uc.dispatcher.Dispatch(ctx, tender.PullEvents()...)
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -65,10 +75,14 @@ func NewChangeTenderStatusUseCase(
 	employeeRepository repositories.EmployeeRepository,
 	orgResponsibleRepository repositories.OrganizationResponsibleRepository,
 	tenderRepository repositories.TenderRepository,
+	transactor repositories.Transactor,
+	dispatcher events.Dispatcher,
 ) ChangeTenderStatusUseCase {
 	return ChangeTenderStatusUseCase{
 		employeeRepository:       employeeRepository,
 		orgResponsibleRepository: orgResponsibleRepository,
 		tenderRepository:         tenderRepository,
+		transactor:               transactor,
+		dispatcher:               dispatcher,
 	}
 }
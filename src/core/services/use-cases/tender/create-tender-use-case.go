@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 	"tms/src/core/domain"
+	"tms/src/core/services/events"
 	"tms/src/core/services/repositories"
 )
 
@@ -13,16 +14,25 @@ type CreateTenderDTO struct {
 	ServiceType     string `json:"serviceType"`
 	OrganizationID  string `json:"organizationId"`
 	CreatorUsername string `json:"creatorUsername"`
+	// Budget необязателен, без него цены предложений не ограничены
+	Budget *domain.Money `json:"budget"`
+	// SubmissionDeadline и EvaluationDeadline необязательны
+	SubmissionDeadline *time.Time `json:"submissionDeadline"`
+	EvaluationDeadline *time.Time `json:"evaluationDeadline"`
+	// PublishAt время автоматической публикации, необязательно
+	PublishAt *time.Time `json:"publishAt"`
 }
 
 type CreateTenderUseCase struct {
 	employeeRepository                repositories.EmployeeRepository
 	organizationResponsibleRepository repositories.OrganizationResponsibleRepository
 	tenderRepository                  repositories.TenderRepository
+	transactor                        repositories.Transactor
+	dispatcher                        events.Dispatcher
 }
 
-func (uc *CreateTenderUseCase) Execute(dto CreateTenderDTO) (*domain.Tender, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+func (uc *CreateTenderUseCase) Execute(ctx context.Context, dto CreateTenderDTO) (*domain.Tender, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	employee, err := uc.employeeRepository.Get(ctx, repositories.GetEmployeeDTO{
@@ -39,12 +49,23 @@ func (uc *CreateTenderUseCase) Execute(dto CreateTenderDTO) (*domain.Tender, err
 		return nil, err
 	}
 
-	tender, err := domain.NewTender(dto.Name, dto.Description, dto.ServiceType, dto.OrganizationID, *orgResponsible)
+	tender, err := domain.NewTender(dto.Name, dto.Description, dto.ServiceType, dto.OrganizationID, dto.Budget, dto.SubmissionDeadline, dto.EvaluationDeadline, *orgResponsible)
 	if err != nil {
 		return nil, err
 	}
 
-	err = uc.tenderRepository.Save(ctx, *tender)
+	if dto.PublishAt != nil {
+		if err := tender.Schedule(*orgResponsible, dto.PublishAt); err != nil {
+			return nil, err
+		}
+	}
+
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.tenderRepository.Save(ctx, *tender); err != nil {
+			return err
+		}
+		return uc.dispatcher.Dispatch(ctx, tender.PullEvents()...)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -56,10 +77,14 @@ func NewCreateTenderUseCase(
 	organizationResponsibleRepository repositories.OrganizationResponsibleRepository,
 	tenderRepository repositories.TenderRepository,
 	employeeRepository repositories.EmployeeRepository,
+	transactor repositories.Transactor,
+	dispatcher events.Dispatcher,
 ) CreateTenderUseCase {
 	return CreateTenderUseCase{
 		organizationResponsibleRepository: organizationResponsibleRepository,
 		tenderRepository:                  tenderRepository,
 		employeeRepository:                employeeRepository,
+		transactor:                        transactor,
+		dispatcher:                        dispatcher,
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 	"tms/src/core/domain"
+	"tms/src/core/services/events"
 	"tms/src/core/services/repositories"
 )
 
@@ -13,16 +14,22 @@ type EditTenderUseCaseDTO struct {
 	Name        *string
 	Description *string
 	ServiceType *string
+	Budget      *domain.Money
+	// SubmissionDeadline и EvaluationDeadline изменяются, только если переданы
+	SubmissionDeadline *time.Time
+	EvaluationDeadline *time.Time
 }
 
 type EditTenderUseCase struct {
 	employeeRepository                repositories.EmployeeRepository
 	organizationResponsibleRepository repositories.OrganizationResponsibleRepository
 	tenderRepository                  repositories.TenderRepository
+	transactor                        repositories.Transactor
+	dispatcher                        events.Dispatcher
 }
 
-func (uc EditTenderUseCase) Execute(dto EditTenderUseCaseDTO) (*domain.Tender, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func (uc EditTenderUseCase) Execute(ctx context.Context, dto EditTenderUseCaseDTO) (*domain.Tender, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	employee, err := uc.employeeRepository.Get(ctx, repositories.GetEmployeeDTO{
@@ -47,11 +54,17 @@ func (uc EditTenderUseCase) Execute(dto EditTenderUseCaseDTO) (*domain.Tender, e
 		return nil, err
 	}
 
-	if err = tender.Edit(*orgResponsible, dto.Name, dto.Description, dto.ServiceType); err != nil {
+	if err = tender.Edit(*orgResponsible, dto.Name, dto.Description, dto.ServiceType, dto.Budget, dto.SubmissionDeadline, dto.EvaluationDeadline); err != nil {
 		return nil, err
 	}
 
-	if err = uc.tenderRepository.Save(ctx, *tender); err != nil {
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.tenderRepository.Save(ctx, *tender); err != nil {
+			return err
+		}
+		return uc.dispatcher.Dispatch(ctx, tender.PullEvents()...)
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -62,10 +75,14 @@ func NewEditTenderUseCase(
 	employeeRepository repositories.EmployeeRepository,
 	organizationResponsibleRepository repositories.OrganizationResponsibleRepository,
 	tenderRepository repositories.TenderRepository,
+	transactor repositories.Transactor,
+	dispatcher events.Dispatcher,
 ) EditTenderUseCase {
 	return EditTenderUseCase{
 		organizationResponsibleRepository: organizationResponsibleRepository,
 		tenderRepository:                  tenderRepository,
 		employeeRepository:                employeeRepository,
+		transactor:                        transactor,
+		dispatcher:                        dispatcher,
 	}
 }
@@ -2,51 +2,127 @@ package use_cases
 
 import (
 	"context"
+	"github.com/pkg/errors"
 	"time"
 	"tms/src/core/domain"
 	"tms/src/core/services/repositories"
 )
 
 type GetAllTendersDTO struct {
-	Limit       *int    `json:"limit"`
-	Offset      *int    `json:"offset"`
-	ServiceType *string `json:"service_type"`
+	Limit           *int     `json:"limit"`
+	Offset          *int     `json:"offset"`
+	ServiceTypes    []string `json:"service_type"`
+	OrganizationIDs []string `json:"organization_id"`
+	Query           *string  `json:"q"`
+	CreatedFrom     *string  `json:"created_from"`
+	CreatedTo       *string  `json:"created_to"`
+	UpdatedSince    *string  `json:"updated_since"`
+	BudgetCurrency  *string  `json:"budget_currency"`
+	MinBudget       *string  `json:"budget_min"`
+	MaxBudget       *string  `json:"budget_max"`
+	SortBy          *string  `json:"sort_by"`
+	SortOrder       *string  `json:"sort_order"`
+	Cursor          *string  `json:"cursor"`
+	IncludeTotal    bool     `json:"include_total"`
 }
 
 type GetAllTendersUseCase struct {
 	tenderRepository repositories.TenderRepository
 }
 
-func (uc GetAllTendersUseCase) Execute(dto GetAllTendersDTO) ([]domain.Tender, error) {
+func (uc GetAllTendersUseCase) Execute(dto GetAllTendersDTO) (*repositories.Page[repositories.TenderSearchResult], error) {
 	limit := repositories.NewLimit(dto.Limit)
 	offset := repositories.NewOffset(dto.Offset)
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	var serviceType *domain.TenderServiceType
-
-	if dto.ServiceType != nil {
-		st, err := domain.NewTenderServiceType(*dto.ServiceType)
+	serviceTypes := make([]domain.TenderServiceType, 0, len(dto.ServiceTypes))
+	for _, s := range dto.ServiceTypes {
+		st, err := domain.NewTenderServiceType(s)
 		if err != nil {
 			return nil, err
 		}
-		serviceType = &st
+		serviceTypes = append(serviceTypes, st)
 	}
 
-	status := domain.TenderPublishedStatus
-	tenders, err := uc.tenderRepository.GetList(ctx, repositories.GetTendersListDTO{
-		ServiceType: serviceType,
-		Offset:      &offset,
-		Limit:       &limit,
-		Status:      &status,
-	})
+	organizationIDs := make([]domain.ID, 0, len(dto.OrganizationIDs))
+	for _, id := range dto.OrganizationIDs {
+		organizationIDs = append(organizationIDs, domain.ID(id))
+	}
+
+	timeFilter, err := repositories.NewTimeFilter(dto.CreatedFrom, dto.CreatedTo, dto.UpdatedSince)
+	if err != nil {
+		return nil, err
+	}
+
+	budgetFilter, err := repositories.NewAmountFilter(dto.BudgetCurrency, dto.MinBudget, dto.MaxBudget)
+	if err != nil {
+		return nil, err
+	}
+
+	search, err := repositories.NewSearchQuery(dto.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	sort, err := repositories.NewTenderSort(dto.SortBy, dto.SortOrder, search != nil)
+	if err != nil {
+		return nil, err
+	}
 
+	cursor, err := repositories.NewCursor(dto.Cursor)
 	if err != nil {
 		return nil, err
 	}
+	if cursor != nil && cursor.Sort != sort {
+		return nil, errors.Wrap(domain.ErrValidation, "cursor was issued for a different sort")
+	}
+
+	// Запрашивается на один элемент больше, чтобы понять, есть ли следующая страница
+	fetch := limit + 1
+	listDTO := repositories.GetTendersListDTO{
+		TimeFilter:      timeFilter,
+		Budget:          budgetFilter,
+		ServiceTypes:    serviceTypes,
+		OrganizationIDs: organizationIDs,
+		Query:           search,
+		Statuses:        []domain.TenderStatus{domain.TenderPublishedStatus},
+		Sort:            &sort,
+		After:           cursor,
+		Limit:           &fetch,
+	}
+	if cursor == nil {
+		listDTO.Offset = &offset
+	}
+
+	var tenders []repositories.TenderSearchResult
+
+	if search != nil {
+		tenders, err = uc.tenderRepository.Search(ctx, listDTO)
+	} else {
+		var list []domain.Tender
+		list, err = uc.tenderRepository.GetList(ctx, listDTO)
+		tenders = repositories.TenderSearchResults(list)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	page := repositories.NewPage(tenders, limit, func(t repositories.TenderSearchResult) repositories.Cursor {
+		return t.Cursor(sort)
+	})
+
+	if dto.IncludeTotal {
+		total, err := uc.tenderRepository.Count(ctx, listDTO)
+		if err != nil {
+			return nil, err
+		}
+		page.Total = &total
+	}
 
-	return tenders, nil
+	return &page, nil
 }
 
 func NewGetAllTendersUseCase(tenderRepository repositories.TenderRepository) GetAllTendersUseCase {
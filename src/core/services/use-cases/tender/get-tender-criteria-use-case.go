@@ -0,0 +1,63 @@
+package use_cases
+
+import (
+	"context"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+type GetTenderCriteriaUseCase struct {
+	employeeRepository       repositories.EmployeeRepository
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository
+	tenderRepository         repositories.TenderRepository
+	scoringRepository        repositories.ScoringRepository
+}
+
+type GetTenderCriteriaDTO struct {
+	TenderID string
+	Username string
+}
+
+func (uc GetTenderCriteriaUseCase) Execute(dto GetTenderCriteriaDTO) (domain.ScoringCriteria, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	employee, err := uc.employeeRepository.Get(ctx, repositories.GetEmployeeDTO{
+		Username: &dto.Username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	orgResponsible, err := uc.orgResponsibleRepository.Get(ctx, repositories.GetOrganizationResponsibleDTO{
+		EmployeeID: employee.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tender, err := uc.tenderRepository.Get(ctx, repositories.GetTenderDTO{
+		ID:             domain.ID(dto.TenderID),
+		OrganizationID: &orgResponsible.OrganizationID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.scoringRepository.GetCriteria(ctx, tender.ID)
+}
+
+func NewGetTenderCriteriaUseCase(
+	employeeRepository repositories.EmployeeRepository,
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository,
+	tenderRepository repositories.TenderRepository,
+	scoringRepository repositories.ScoringRepository,
+) GetTenderCriteriaUseCase {
+	return GetTenderCriteriaUseCase{
+		employeeRepository:       employeeRepository,
+		orgResponsibleRepository: orgResponsibleRepository,
+		tenderRepository:         tenderRepository,
+		scoringRepository:        scoringRepository,
+	}
+}
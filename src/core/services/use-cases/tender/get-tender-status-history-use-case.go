@@ -0,0 +1,60 @@
+package use_cases
+
+import (
+	"context"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+type GetTenderStatusHistoryUseCase struct {
+	employeeRepository       repositories.EmployeeRepository
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository
+	tenderRepository         repositories.TenderRepository
+}
+
+type GetTenderStatusHistoryDTO struct {
+	TenderID string
+	Username string
+}
+
+func (uc GetTenderStatusHistoryUseCase) Execute(dto GetTenderStatusHistoryDTO) ([]domain.TenderStatusChange, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	employee, err := uc.employeeRepository.Get(ctx, repositories.GetEmployeeDTO{
+		Username: &dto.Username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	orgResponsible, err := uc.orgResponsibleRepository.Get(ctx, repositories.GetOrganizationResponsibleDTO{
+		EmployeeID: employee.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tender, err := uc.tenderRepository.Get(ctx, repositories.GetTenderDTO{
+		ID:             domain.ID(dto.TenderID),
+		OrganizationID: &orgResponsible.OrganizationID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.tenderRepository.GetStatusHistory(ctx, tender.ID)
+}
+
+func NewGetTenderStatusHistoryUseCase(
+	employeeRepository repositories.EmployeeRepository,
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository,
+	tenderRepository repositories.TenderRepository,
+) GetTenderStatusHistoryUseCase {
+	return GetTenderStatusHistoryUseCase{
+		employeeRepository:       employeeRepository,
+		orgResponsibleRepository: orgResponsibleRepository,
+		tenderRepository:         tenderRepository,
+	}
+}
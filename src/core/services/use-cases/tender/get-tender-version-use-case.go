@@ -0,0 +1,61 @@
+package use_cases
+
+import (
+	"context"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+type GetTenderVersionUseCase struct {
+	employeeRepository       repositories.EmployeeRepository
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository
+	tenderRepository         repositories.TenderRepository
+}
+
+type GetTenderVersionDTO struct {
+	TenderID string
+	Version  int
+	Username string
+}
+
+func (uc GetTenderVersionUseCase) Execute(dto GetTenderVersionDTO) (*domain.TenderSnapshot, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	employee, err := uc.employeeRepository.Get(ctx, repositories.GetEmployeeDTO{
+		Username: &dto.Username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	orgResponsible, err := uc.orgResponsibleRepository.Get(ctx, repositories.GetOrganizationResponsibleDTO{
+		EmployeeID: employee.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tender, err := uc.tenderRepository.Get(ctx, repositories.GetTenderDTO{
+		ID:             domain.ID(dto.TenderID),
+		OrganizationID: &orgResponsible.OrganizationID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tender.FindVersion(dto.Version)
+}
+
+func NewGetTenderVersionUseCase(
+	employeeRepository repositories.EmployeeRepository,
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository,
+	tenderRepository repositories.TenderRepository,
+) GetTenderVersionUseCase {
+	return GetTenderVersionUseCase{
+		employeeRepository:       employeeRepository,
+		orgResponsibleRepository: orgResponsibleRepository,
+		tenderRepository:         tenderRepository,
+	}
+}
@@ -0,0 +1,62 @@
+package use_cases
+
+import (
+	"context"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+type GetTenderVersionsDiffUseCase struct {
+	employeeRepository       repositories.EmployeeRepository
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository
+	tenderRepository         repositories.TenderRepository
+}
+
+type GetTenderVersionsDiffDTO struct {
+	TenderID string
+	From     int
+	To       int
+	Username string
+}
+
+func (uc GetTenderVersionsDiffUseCase) Execute(dto GetTenderVersionsDiffDTO) (*domain.VersionDiff, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	employee, err := uc.employeeRepository.Get(ctx, repositories.GetEmployeeDTO{
+		Username: &dto.Username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	orgResponsible, err := uc.orgResponsibleRepository.Get(ctx, repositories.GetOrganizationResponsibleDTO{
+		EmployeeID: employee.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tender, err := uc.tenderRepository.Get(ctx, repositories.GetTenderDTO{
+		ID:             domain.ID(dto.TenderID),
+		OrganizationID: &orgResponsible.OrganizationID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tender.DiffVersions(dto.From, dto.To)
+}
+
+func NewGetTenderVersionsDiffUseCase(
+	employeeRepository repositories.EmployeeRepository,
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository,
+	tenderRepository repositories.TenderRepository,
+) GetTenderVersionsDiffUseCase {
+	return GetTenderVersionsDiffUseCase{
+		employeeRepository:       employeeRepository,
+		orgResponsibleRepository: orgResponsibleRepository,
+		tenderRepository:         tenderRepository,
+	}
+}
@@ -2,6 +2,7 @@ package use_cases
 
 import (
 	"context"
+	"github.com/pkg/errors"
 	"time"
 	"tms/src/core/domain"
 	"tms/src/core/services/repositories"
@@ -14,12 +15,24 @@ type GetUserTendersUseCase struct {
 }
 
 type GetUserTendersDTO struct {
-	Limit    *int   `json:"limit"`
-	Offset   *int   `json:"offset"`
-	Username string `json:"username"`
+	Limit          *int     `json:"limit"`
+	Offset         *int     `json:"offset"`
+	Username       string   `json:"username"`
+	Statuses       []string `json:"status"`
+	ServiceTypes   []string `json:"service_type"`
+	CreatedFrom    *string  `json:"created_from"`
+	CreatedTo      *string  `json:"created_to"`
+	UpdatedSince   *string  `json:"updated_since"`
+	BudgetCurrency *string  `json:"budget_currency"`
+	MinBudget      *string  `json:"budget_min"`
+	MaxBudget      *string  `json:"budget_max"`
+	SortBy         *string  `json:"sort_by"`
+	SortOrder      *string  `json:"sort_order"`
+	Cursor         *string  `json:"cursor"`
+	IncludeTotal   bool     `json:"include_total"`
 }
 
-func (uc GetUserTendersUseCase) Execute(dto GetUserTendersDTO) ([]domain.Tender, error) {
+func (uc GetUserTendersUseCase) Execute(dto GetUserTendersDTO) (*repositories.Page[domain.Tender], error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -37,18 +50,83 @@ func (uc GetUserTendersUseCase) Execute(dto GetUserTendersDTO) ([]domain.Tender,
 		return nil, err
 	}
 
+	statuses := make([]domain.TenderStatus, 0, len(dto.Statuses))
+	for _, s := range dto.Statuses {
+		status, err := domain.NewTenderStatus(s)
+		if err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, status)
+	}
+
+	serviceTypes := make([]domain.TenderServiceType, 0, len(dto.ServiceTypes))
+	for _, s := range dto.ServiceTypes {
+		st, err := domain.NewTenderServiceType(s)
+		if err != nil {
+			return nil, err
+		}
+		serviceTypes = append(serviceTypes, st)
+	}
+
+	timeFilter, err := repositories.NewTimeFilter(dto.CreatedFrom, dto.CreatedTo, dto.UpdatedSince)
+	if err != nil {
+		return nil, err
+	}
+
+	budgetFilter, err := repositories.NewAmountFilter(dto.BudgetCurrency, dto.MinBudget, dto.MaxBudget)
+	if err != nil {
+		return nil, err
+	}
+
+	sort, err := repositories.NewTenderSort(dto.SortBy, dto.SortOrder, false)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := repositories.NewCursor(dto.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	if cursor != nil && cursor.Sort != sort {
+		return nil, errors.Wrap(domain.ErrValidation, "cursor was issued for a different sort")
+	}
+
+	// Запрашивается на один элемент больше, чтобы понять, есть ли следующая страница
 	limit := repositories.NewLimit(dto.Limit)
 	offset := repositories.NewOffset(dto.Offset)
-	tenders, err := uc.tenderRepository.GetList(ctx, repositories.GetTendersListDTO{
-		OrganizationID: &orgResponsible.OrganizationID,
-		Offset:         &offset,
-		Limit:          &limit,
-	})
+	fetch := limit + 1
+	listDTO := repositories.GetTendersListDTO{
+		TimeFilter:      timeFilter,
+		Budget:          budgetFilter,
+		OrganizationIDs: []domain.ID{orgResponsible.OrganizationID},
+		Statuses:        statuses,
+		ServiceTypes:    serviceTypes,
+		Sort:            &sort,
+		After:           cursor,
+		Limit:           &fetch,
+	}
+	if cursor == nil {
+		listDTO.Offset = &offset
+	}
+
+	tenders, err := uc.tenderRepository.GetList(ctx, listDTO)
 	if err != nil {
 		return nil, err
 	}
 
-	return tenders, nil
+	page := repositories.NewPage(tenders, limit, func(t domain.Tender) repositories.Cursor {
+		return repositories.TenderCursor(sort, t)
+	})
+
+	if dto.IncludeTotal {
+		total, err := uc.tenderRepository.Count(ctx, listDTO)
+		if err != nil {
+			return nil, err
+		}
+		page.Total = &total
+	}
+
+	return &page, nil
 }
 
 func NewGetUserTendersUseCase(
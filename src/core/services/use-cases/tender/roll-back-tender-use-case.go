@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 	"tms/src/core/domain"
+	"tms/src/core/services/events"
 	"tms/src/core/services/repositories"
 )
 
@@ -11,16 +12,26 @@ type RollBackTenderUseCase struct {
 	employeeRepository       repositories.EmployeeRepository
 	orgResponsibleRepository repositories.OrganizationResponsibleRepository
 	tenderRepository         repositories.TenderRepository
+	transactor               repositories.Transactor
+	dispatcher               events.Dispatcher
 }
 
 type RollBackTenderUseCaseDTO struct {
 	TenderID string
 	Version  int
 	Username string
+	// DryRun вычислить результат отката без сохранения
+	DryRun bool
 }
 
-func (uc RollBackTenderUseCase) Execute(dto RollBackTenderUseCaseDTO) (*domain.Tender, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+// RollBackTenderResult Результат отката и изменения относительно версии до отката
+type RollBackTenderResult struct {
+	Tender *domain.Tender     `json:"tender"`
+	Diff   domain.VersionDiff `json:"diff"`
+}
+
+func (uc RollBackTenderUseCase) Execute(ctx context.Context, dto RollBackTenderUseCaseDTO) (*RollBackTenderResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	employee, err := uc.employeeRepository.Get(ctx, repositories.GetEmployeeDTO{
@@ -46,25 +57,46 @@ func (uc RollBackTenderUseCase) Execute(dto RollBackTenderUseCaseDTO) (*domain.T
 		return nil, err
 	}
 
+	before := tender.CurrentVersion()
+
 	if err := tender.Rollback(*orgResponsible, dto.Version); err != nil {
 		return nil, err
 	}
 
-	if err = uc.tenderRepository.Save(ctx, *tender); err != nil {
+	result := &RollBackTenderResult{
+		Tender: tender,
+		Diff:   before.Diff(tender.CurrentVersion()),
+	}
+
+	if dto.DryRun {
+		return result, nil
+	}
+
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.tenderRepository.Save(ctx, *tender); err != nil {
+			return err
+		}
+		return uc.dispatcher.Dispatch(ctx, tender.PullEvents()...)
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	return tender, nil
+	return result, nil
 }
 
 func NewRollBackTenderUseCase(
 	employeeRepository repositories.EmployeeRepository,
 	orgResponsibleRepository repositories.OrganizationResponsibleRepository,
 	tenderRepository repositories.TenderRepository,
+	transactor repositories.Transactor,
+	dispatcher events.Dispatcher,
 ) RollBackTenderUseCase {
 	return RollBackTenderUseCase{
 		employeeRepository:       employeeRepository,
 		orgResponsibleRepository: orgResponsibleRepository,
 		tenderRepository:         tenderRepository,
+		transactor:               transactor,
+		dispatcher:               dispatcher,
 	}
 }
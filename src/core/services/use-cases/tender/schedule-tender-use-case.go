@@ -0,0 +1,82 @@
+package use_cases
+
+import (
+	"context"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/events"
+	"tms/src/core/services/repositories"
+)
+
+type ScheduleTenderDTO struct {
+	TenderID string
+	Username string
+	// PublishAt новое время публикации, nil отменяет запланированную публикацию
+	PublishAt *time.Time
+}
+
+type ScheduleTenderUseCase struct {
+	employeeRepository                repositories.EmployeeRepository
+	organizationResponsibleRepository repositories.OrganizationResponsibleRepository
+	tenderRepository                  repositories.TenderRepository
+	transactor                        repositories.Transactor
+	dispatcher                        events.Dispatcher
+}
+
+func (uc ScheduleTenderUseCase) Execute(ctx context.Context, dto ScheduleTenderDTO) (*domain.Tender, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	employee, err := uc.employeeRepository.Get(ctx, repositories.GetEmployeeDTO{
+		Username: &dto.Username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	orgResponsible, err := uc.organizationResponsibleRepository.Get(ctx, repositories.GetOrganizationResponsibleDTO{
+		EmployeeID: employee.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tender, err := uc.tenderRepository.Get(ctx, repositories.GetTenderDTO{
+		ID: domain.ID(dto.TenderID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tender.Schedule(*orgResponsible, dto.PublishAt); err != nil {
+		return nil, err
+	}
+
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.tenderRepository.Save(ctx, *tender); err != nil {
+			return err
+		}
+		return uc.dispatcher.Dispatch(ctx, tender.PullEvents()...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tender, nil
+}
+
+func NewScheduleTenderUseCase(
+	employeeRepository repositories.EmployeeRepository,
+	organizationResponsibleRepository repositories.OrganizationResponsibleRepository,
+	tenderRepository repositories.TenderRepository,
+	transactor repositories.Transactor,
+	dispatcher events.Dispatcher,
+) ScheduleTenderUseCase {
+	return ScheduleTenderUseCase{
+		employeeRepository:                employeeRepository,
+		organizationResponsibleRepository: organizationResponsibleRepository,
+		tenderRepository:                  tenderRepository,
+		transactor:                        transactor,
+		dispatcher:                        dispatcher,
+	}
+}
@@ -0,0 +1,89 @@
+package use_cases
+
+import (
+	"context"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/events"
+	"tms/src/core/services/repositories"
+)
+
+type SetTenderCriteriaDTO struct {
+	TenderID string
+	Username string
+	// Criteria новые критерии оценки, пустой список отключает оценку предложений
+	Criteria []domain.ScoringCriterion
+}
+
+type SetTenderCriteriaUseCase struct {
+	employeeRepository       repositories.EmployeeRepository
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository
+	tenderRepository         repositories.TenderRepository
+	scoringRepository        repositories.ScoringRepository
+	transactor               repositories.Transactor
+	dispatcher               events.Dispatcher
+}
+
+// Execute заменяет критерии оценки тендера. Уже выставленные оценки сохраняются,
+// но в рейтинге учитываются только оценки по текущим критериям
+func (uc SetTenderCriteriaUseCase) Execute(ctx context.Context, dto SetTenderCriteriaDTO) (domain.ScoringCriteria, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	employee, err := uc.employeeRepository.Get(ctx, repositories.GetEmployeeDTO{
+		Username: &dto.Username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	orgResponsible, err := uc.orgResponsibleRepository.Get(ctx, repositories.GetOrganizationResponsibleDTO{
+		EmployeeID: employee.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tender, err := uc.tenderRepository.Get(ctx, repositories.GetTenderDTO{
+		ID:             domain.ID(dto.TenderID),
+		OrganizationID: &orgResponsible.OrganizationID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	criteria, err := domain.NewScoringCriteria(dto.Criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.scoringRepository.SaveCriteria(ctx, tender.ID, criteria); err != nil {
+			return err
+		}
+		return uc.dispatcher.Dispatch(ctx, domain.NewTenderCriteriaSet(*tender, employee.ID, criteria))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return criteria, nil
+}
+
+func NewSetTenderCriteriaUseCase(
+	employeeRepository repositories.EmployeeRepository,
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository,
+	tenderRepository repositories.TenderRepository,
+	scoringRepository repositories.ScoringRepository,
+	transactor repositories.Transactor,
+	dispatcher events.Dispatcher,
+) SetTenderCriteriaUseCase {
+	return SetTenderCriteriaUseCase{
+		employeeRepository:       employeeRepository,
+		orgResponsibleRepository: orgResponsibleRepository,
+		tenderRepository:         tenderRepository,
+		scoringRepository:        scoringRepository,
+		transactor:               transactor,
+		dispatcher:               dispatcher,
+	}
+}
@@ -0,0 +1,54 @@
+package use_cases
+
+import (
+	"context"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+type CreateWebhookDTO struct {
+	Username string   `json:"username"`
+	URL      string   `json:"url"`
+	Events   []string `json:"events"`
+	Secret   string   `json:"secret"`
+}
+
+type CreateWebhookUseCase struct {
+	employeeRepository       repositories.EmployeeRepository
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository
+	webhookRepository        repositories.WebhookRepository
+}
+
+func (uc CreateWebhookUseCase) Execute(ctx context.Context, dto CreateWebhookDTO) (*domain.Webhook, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	orgResponsible, err := organizationResponsible(ctx, uc.employeeRepository, uc.orgResponsibleRepository, dto.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook, err := domain.NewWebhook(dto.URL, dto.Events, dto.Secret, *orgResponsible)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.webhookRepository.Save(ctx, *webhook); err != nil {
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+func NewCreateWebhookUseCase(
+	employeeRepository repositories.EmployeeRepository,
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository,
+	webhookRepository repositories.WebhookRepository,
+) CreateWebhookUseCase {
+	return CreateWebhookUseCase{
+		employeeRepository:       employeeRepository,
+		orgResponsibleRepository: orgResponsibleRepository,
+		webhookRepository:        webhookRepository,
+	}
+}
@@ -0,0 +1,52 @@
+package use_cases
+
+import (
+	"context"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+type DeleteWebhookDTO struct {
+	WebhookID string
+	Username  string
+}
+
+type DeleteWebhookUseCase struct {
+	employeeRepository       repositories.EmployeeRepository
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository
+	webhookRepository        repositories.WebhookRepository
+}
+
+func (uc DeleteWebhookUseCase) Execute(ctx context.Context, dto DeleteWebhookDTO) (*domain.Webhook, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	orgResponsible, err := organizationResponsible(ctx, uc.employeeRepository, uc.orgResponsibleRepository, dto.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook, err := accessibleWebhook(ctx, uc.webhookRepository, *orgResponsible, dto.WebhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.webhookRepository.Delete(ctx, webhook.ID); err != nil {
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+func NewDeleteWebhookUseCase(
+	employeeRepository repositories.EmployeeRepository,
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository,
+	webhookRepository repositories.WebhookRepository,
+) DeleteWebhookUseCase {
+	return DeleteWebhookUseCase{
+		employeeRepository:       employeeRepository,
+		orgResponsibleRepository: orgResponsibleRepository,
+		webhookRepository:        webhookRepository,
+	}
+}
@@ -0,0 +1,59 @@
+package use_cases
+
+import (
+	"context"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+type EditWebhookDTO struct {
+	WebhookID string
+	Username  string
+	URL       *string
+	Events    []string
+	Secret    *string
+}
+
+type EditWebhookUseCase struct {
+	employeeRepository       repositories.EmployeeRepository
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository
+	webhookRepository        repositories.WebhookRepository
+}
+
+func (uc EditWebhookUseCase) Execute(ctx context.Context, dto EditWebhookDTO) (*domain.Webhook, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	orgResponsible, err := organizationResponsible(ctx, uc.employeeRepository, uc.orgResponsibleRepository, dto.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook, err := accessibleWebhook(ctx, uc.webhookRepository, *orgResponsible, dto.WebhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := webhook.Edit(*orgResponsible, dto.URL, dto.Events, dto.Secret); err != nil {
+		return nil, err
+	}
+
+	if err := uc.webhookRepository.Save(ctx, *webhook); err != nil {
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+func NewEditWebhookUseCase(
+	employeeRepository repositories.EmployeeRepository,
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository,
+	webhookRepository repositories.WebhookRepository,
+) EditWebhookUseCase {
+	return EditWebhookUseCase{
+		employeeRepository:       employeeRepository,
+		orgResponsibleRepository: orgResponsibleRepository,
+		webhookRepository:        webhookRepository,
+	}
+}
@@ -0,0 +1,71 @@
+package use_cases
+
+import (
+	"context"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+type GetWebhookDeliveriesDTO struct {
+	WebhookID string  `json:"webhookId"`
+	Username  string  `json:"username"`
+	Status    *string `json:"status"`
+	Limit     *int    `json:"limit"`
+	Offset    *int    `json:"offset"`
+}
+
+type GetWebhookDeliveriesUseCase struct {
+	employeeRepository       repositories.EmployeeRepository
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository
+	webhookRepository        repositories.WebhookRepository
+	deliveryRepository       repositories.WebhookDeliveryRepository
+}
+
+func (uc GetWebhookDeliveriesUseCase) Execute(dto GetWebhookDeliveriesDTO) ([]domain.WebhookDelivery, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	orgResponsible, err := organizationResponsible(ctx, uc.employeeRepository, uc.orgResponsibleRepository, dto.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook, err := accessibleWebhook(ctx, uc.webhookRepository, *orgResponsible, dto.WebhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	listDTO := repositories.GetWebhookDeliveriesListDTO{
+		WebhookID: webhook.ID,
+	}
+
+	if dto.Status != nil {
+		status, err := domain.NewWebhookDeliveryStatus(*dto.Status)
+		if err != nil {
+			return nil, err
+		}
+		listDTO.Status = &status
+	}
+
+	limit := repositories.NewLimit(dto.Limit)
+	offset := repositories.NewOffset(dto.Offset)
+	listDTO.Limit = &limit
+	listDTO.Offset = &offset
+
+	return uc.deliveryRepository.GetList(ctx, listDTO)
+}
+
+func NewGetWebhookDeliveriesUseCase(
+	employeeRepository repositories.EmployeeRepository,
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository,
+	webhookRepository repositories.WebhookRepository,
+	deliveryRepository repositories.WebhookDeliveryRepository,
+) GetWebhookDeliveriesUseCase {
+	return GetWebhookDeliveriesUseCase{
+		employeeRepository:       employeeRepository,
+		orgResponsibleRepository: orgResponsibleRepository,
+		webhookRepository:        webhookRepository,
+		deliveryRepository:       deliveryRepository,
+	}
+}
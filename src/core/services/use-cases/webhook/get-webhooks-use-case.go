@@ -0,0 +1,44 @@
+package use_cases
+
+import (
+	"context"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+type GetWebhooksDTO struct {
+	Username string `json:"username"`
+}
+
+type GetWebhooksUseCase struct {
+	employeeRepository       repositories.EmployeeRepository
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository
+	webhookRepository        repositories.WebhookRepository
+}
+
+func (uc GetWebhooksUseCase) Execute(dto GetWebhooksDTO) ([]domain.Webhook, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	orgResponsible, err := organizationResponsible(ctx, uc.employeeRepository, uc.orgResponsibleRepository, dto.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.webhookRepository.GetList(ctx, repositories.GetWebhooksListDTO{
+		OrganizationID: orgResponsible.OrganizationID,
+	})
+}
+
+func NewGetWebhooksUseCase(
+	employeeRepository repositories.EmployeeRepository,
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository,
+	webhookRepository repositories.WebhookRepository,
+) GetWebhooksUseCase {
+	return GetWebhooksUseCase{
+		employeeRepository:       employeeRepository,
+		orgResponsibleRepository: orgResponsibleRepository,
+		webhookRepository:        webhookRepository,
+	}
+}
@@ -0,0 +1,46 @@
+package use_cases
+
+import (
+	"context"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+// organizationResponsible возвращает ответственного за организацию сотрудника с именем username.
+// Вебхуками управляют только ответственные за организацию
+func organizationResponsible(
+	ctx context.Context,
+	employeeRepository repositories.EmployeeRepository,
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository,
+	username string,
+) (*domain.OrganizationResponsible, error) {
+	employee, err := employeeRepository.Get(ctx, repositories.GetEmployeeDTO{
+		Username: &username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return orgResponsibleRepository.Get(ctx, repositories.GetOrganizationResponsibleDTO{
+		EmployeeID: employee.ID,
+	})
+}
+
+// accessibleWebhook возвращает вебхук webhookID, если executor отвечает за его организацию
+func accessibleWebhook(
+	ctx context.Context,
+	webhookRepository repositories.WebhookRepository,
+	executor domain.OrganizationResponsible,
+	webhookID string,
+) (*domain.Webhook, error) {
+	webhook, err := webhookRepository.Get(ctx, domain.ID(webhookID))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := webhook.CheckAccess(executor); err != nil {
+		return nil, err
+	}
+
+	return webhook, nil
+}
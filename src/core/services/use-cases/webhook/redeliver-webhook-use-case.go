@@ -0,0 +1,71 @@
+package use_cases
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+type RedeliverWebhookDTO struct {
+	WebhookID  string
+	DeliveryID string
+	Username   string
+}
+
+// RedeliverWebhookUseCase возвращает доставку из dead-letter списка в очередь отправки
+type RedeliverWebhookUseCase struct {
+	employeeRepository       repositories.EmployeeRepository
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository
+	webhookRepository        repositories.WebhookRepository
+	deliveryRepository       repositories.WebhookDeliveryRepository
+}
+
+func (uc RedeliverWebhookUseCase) Execute(ctx context.Context, dto RedeliverWebhookDTO) (*domain.WebhookDelivery, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	orgResponsible, err := organizationResponsible(ctx, uc.employeeRepository, uc.orgResponsibleRepository, dto.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook, err := accessibleWebhook(ctx, uc.webhookRepository, *orgResponsible, dto.WebhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := uc.deliveryRepository.Get(ctx, domain.ID(dto.DeliveryID))
+	if err != nil {
+		return nil, err
+	}
+
+	if delivery.WebhookID != webhook.ID {
+		return nil, errors.Wrap(domain.ErrNotFound, "webhook delivery not found")
+	}
+
+	if err := delivery.Redeliver(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.deliveryRepository.Save(ctx, *delivery); err != nil {
+		return nil, err
+	}
+
+	return delivery, nil
+}
+
+func NewRedeliverWebhookUseCase(
+	employeeRepository repositories.EmployeeRepository,
+	orgResponsibleRepository repositories.OrganizationResponsibleRepository,
+	webhookRepository repositories.WebhookRepository,
+	deliveryRepository repositories.WebhookDeliveryRepository,
+) RedeliverWebhookUseCase {
+	return RedeliverWebhookUseCase{
+		employeeRepository:       employeeRepository,
+		orgResponsibleRepository: orgResponsibleRepository,
+		webhookRepository:        webhookRepository,
+		deliveryRepository:       deliveryRepository,
+	}
+}
@@ -0,0 +1,188 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"github.com/pkg/errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/events"
+	"tms/src/core/services/repositories"
+	"tms/src/pkg/jobs"
+	"tms/src/pkg/logger/sl"
+)
+
+const SendWebhooksJob = "send_webhooks"
+
+type SenderConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+	// MaxAttempts после стольких неудачных попыток доставка попадает в dead-letter список
+	MaxAttempts int
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	// Timeout ожидания ответа получателя
+	Timeout time.Duration
+	// Lease на это время выбранные доставки скрываются от других отправителей
+	Lease time.Duration
+	// AllowPrivateTargets разрешает отправку на внутренние адреса, например в локальном окружении
+	AllowPrivateTargets bool
+}
+
+// Sender периодической задачей отправляет ожидающие доставки вебхуков
+type Sender struct {
+	webhookRepository  repositories.WebhookRepository
+	deliveryRepository repositories.WebhookDeliveryRepository
+	client             *http.Client
+	cfg                SenderConfig
+	log                *slog.Logger
+}
+
+func NewSender(
+	webhookRepository repositories.WebhookRepository,
+	deliveryRepository repositories.WebhookDeliveryRepository,
+	cfg SenderConfig,
+	log *slog.Logger,
+) *Sender {
+	return &Sender{
+		webhookRepository:  webhookRepository,
+		deliveryRepository: deliveryRepository,
+		client:             newClient(cfg.Timeout, cfg.AllowPrivateTargets),
+		cfg:                cfg,
+		log:                log,
+	}
+}
+
+// Register регистрирует отправку как периодическую задачу, выполняемую одним экземпляром приложения раз в PollInterval
+func (s *Sender) Register(runner *jobs.Runner) {
+	runner.Every(SendWebhooksJob, s.cfg.PollInterval, s.Handle)
+}
+
+// Handle отправляет пачки доставок, пока не будет выбрана неполная или не истечет аренда задачи
+func (s *Sender) Handle(ctx context.Context, _ jobs.Job) error {
+	for {
+		n, err := s.Process(ctx)
+		if err != nil {
+			return err
+		}
+		if n < s.cfg.BatchSize || ctx.Err() != nil {
+			return nil
+		}
+	}
+}
+
+// Process отправляет одну пачку доставок и возвращает их кол-во.
+// Запросы получателям выполняются вне транзакции, результат каждой доставки сохраняется отдельно.
+// Если ctx отменен, оставшиеся доставки будут выбраны снова после истечения Lease
+func (s *Sender) Process(ctx context.Context) (int, error) {
+	deliveries, err := s.deliveryRepository.Claim(ctx, repositories.ClaimWebhookDeliveriesDTO{
+		Limit: s.cfg.BatchSize,
+		Lease: s.cfg.Lease,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	webhooks := make(map[domain.ID]*domain.Webhook)
+	var result error
+
+	for _, d := range deliveries {
+		if ctx.Err() != nil {
+			break
+		}
+
+		webhook, ok := webhooks[d.WebhookID]
+		if !ok {
+			webhook, err = s.webhookRepository.Get(ctx, d.WebhookID)
+			if err != nil {
+				if ctx.Err() != nil {
+					break
+				}
+				// Ошибка одного вебхука не должна останавливать доставки остальных
+				s.failed(&d, err, nil)
+			} else {
+				webhooks[d.WebhookID] = webhook
+			}
+		}
+
+		if webhook != nil {
+			s.deliver(ctx, *webhook, &d)
+		}
+
+		// Результат сохраняется и после отмены ctx, иначе доставленное событие уйдет повторно
+		err := s.deliveryRepository.Save(context.WithoutCancel(ctx), d)
+		if errors.Is(err, repositories.ErrLeaseLost) {
+			s.log.Warn("webhook delivery lease lost, result discarded",
+				slog.String("webhookId", string(d.WebhookID)),
+				slog.String("deliveryId", string(d.ID)))
+			continue
+		}
+		if err != nil && result == nil {
+			result = err
+		}
+	}
+
+	return len(deliveries), result
+}
+
+// deliver выполняет одну попытку доставки и отмечает ее результат в d
+func (s *Sender) deliver(ctx context.Context, webhook domain.Webhook, d *domain.WebhookDelivery) {
+	status, err := s.send(ctx, webhook, *d)
+	if err == nil {
+		d.Delivered(*status)
+		return
+	}
+
+	s.failed(d, err, status)
+}
+
+// failed отмечает неудачную попытку доставки d и назначает следующую с экспоненциальной задержкой
+func (s *Sender) failed(d *domain.WebhookDelivery, err error, status *int) {
+	next := time.Now().Add(events.Backoff(d.Attempts+1, s.cfg.BaseBackoff, s.cfg.MaxBackoff))
+	d.Failed(err.Error(), status, next, s.cfg.MaxAttempts)
+
+	log := s.log.With(
+		slog.String("webhookId", string(d.WebhookID)),
+		slog.String("deliveryId", string(d.ID)),
+		slog.Int("attempt", d.Attempts),
+		sl.Err(err))
+	if d.Status == domain.WebhookDeliveryDeadStatus {
+		log.Error("webhook delivery moved to dead letters")
+		return
+	}
+	log.Warn("webhook delivery failed")
+}
+
+// send отправляет подписанный запрос и возвращает код ответа, если он был получен.
+// Доставка успешна только при ответе 2xx
+func (s *Sender) send(ctx context.Context, webhook domain.Webhook, d domain.WebhookDelivery) (*int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, string(webhook.URL), bytes.NewReader(d.Payload))
+	if err != nil {
+		return nil, err
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, string(d.EventName))
+	req.Header.Set(DeliveryHeader, string(d.ID))
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(SignatureHeader, Sign(webhook.Secret, timestamp, d.Payload))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	status := resp.StatusCode
+	if status < 200 || status >= 300 {
+		return &status, errors.Errorf("unexpected response status %d", status)
+	}
+
+	return &status, nil
+}
@@ -0,0 +1,187 @@
+package webhooks
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/repositories"
+)
+
+type fakeWebhooks struct {
+	webhook domain.Webhook
+	err     error
+}
+
+func (f fakeWebhooks) GetList(context.Context, repositories.GetWebhooksListDTO) ([]domain.Webhook, error) {
+	return []domain.Webhook{f.webhook}, nil
+}
+
+func (f fakeWebhooks) Get(context.Context, domain.ID) (*domain.Webhook, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &f.webhook, nil
+}
+
+func (f fakeWebhooks) Save(context.Context, domain.Webhook) error { return nil }
+
+func (f fakeWebhooks) Delete(context.Context, domain.ID) error { return nil }
+
+// fakeDeliveries хранит одну доставку, Claim возвращает ее, пока она ожидает отправки
+type fakeDeliveries struct {
+	delivery domain.WebhookDelivery
+	claims   int
+}
+
+func (f *fakeDeliveries) GetList(context.Context, repositories.GetWebhookDeliveriesListDTO) ([]domain.WebhookDelivery, error) {
+	return []domain.WebhookDelivery{f.delivery}, nil
+}
+
+func (f *fakeDeliveries) Get(context.Context, domain.ID) (*domain.WebhookDelivery, error) {
+	return &f.delivery, nil
+}
+
+func (f *fakeDeliveries) Append(_ context.Context, deliveries ...domain.WebhookDelivery) error {
+	f.delivery = deliveries[0]
+	return nil
+}
+
+func (f *fakeDeliveries) Claim(context.Context, repositories.ClaimWebhookDeliveriesDTO) ([]domain.WebhookDelivery, error) {
+	if f.delivery.Status != domain.WebhookDeliveryPendingStatus {
+		return nil, nil
+	}
+	f.claims++
+	token := strconv.Itoa(f.claims)
+	f.delivery.LockToken = &token
+	return []domain.WebhookDelivery{f.delivery}, nil
+}
+
+func (f *fakeDeliveries) Save(_ context.Context, delivery domain.WebhookDelivery) error {
+	if delivery.LockToken != nil && *delivery.LockToken != *f.delivery.LockToken {
+		return repositories.ErrLeaseLost
+	}
+	f.delivery = delivery
+	return nil
+}
+
+func TestSender(t *testing.T) {
+	const secret = "0123456789abcdef"
+
+	responses := []int{http.StatusServiceUnavailable, http.StatusOK}
+	var verified []bool
+
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		timestamp, _ := strconv.ParseInt(r.Header.Get(TimestampHeader), 10, 64)
+		verified = append(verified, Verify(secret, timestamp, body, r.Header.Get(SignatureHeader)))
+
+		w.WriteHeader(responses[0])
+		responses = responses[1:]
+	}))
+	defer receiver.Close()
+
+	executor := domain.OrganizationResponsible{OrganizationID: "org"}
+	webhook, err := domain.NewWebhook(receiver.URL, []string{string(domain.TenderCreatedEvent)}, secret, executor)
+	require.NoError(t, err)
+
+	tender, err := domain.NewTender("Доставка", "Описание", string(domain.TenderDeliveryServiceType), "org", nil, nil, nil, executor)
+	require.NoError(t, err)
+
+	deliveries := &fakeDeliveries{}
+	subscriber := Subscriber(fakeWebhooks{webhook: *webhook}, deliveries, nil)
+	require.NoError(t, subscriber(context.Background(), tender.PullEvents()[0]))
+
+	sender := NewSender(fakeWebhooks{webhook: *webhook}, deliveries, SenderConfig{
+		BatchSize:   10,
+		MaxAttempts: 2,
+		Timeout:     time.Second,
+
+		AllowPrivateTargets: true,
+	}, slog.Default())
+
+	t.Run("retries failed delivery", func(t *testing.T) {
+		_, err := sender.Process(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, domain.WebhookDeliveryPendingStatus, deliveries.delivery.Status)
+		assert.Equal(t, http.StatusServiceUnavailable, *deliveries.delivery.ResponseStatus)
+
+		_, err = sender.Process(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, domain.WebhookDeliveryDeliveredStatus, deliveries.delivery.Status)
+		assert.Equal(t, 2, deliveries.delivery.Attempts)
+		assert.Equal(t, []bool{true, true}, verified)
+	})
+
+	t.Run("moves exhausted delivery to dead letters", func(t *testing.T) {
+		receiver.Close()
+
+		other, err := domain.NewTender("Стройка", "Описание", string(domain.TenderConstructionServiceType), "org", nil, nil, nil, executor)
+		require.NoError(t, err)
+		require.NoError(t, subscriber(context.Background(), other.PullEvents()[0]))
+
+		for range 2 {
+			_, err := sender.Process(context.Background())
+			require.NoError(t, err)
+		}
+		assert.Equal(t, domain.WebhookDeliveryDeadStatus, deliveries.delivery.Status)
+		assert.Equal(t, 2, deliveries.delivery.Attempts)
+		assert.Nil(t, deliveries.delivery.ResponseStatus)
+
+		require.NoError(t, deliveries.delivery.Redeliver())
+		assert.Equal(t, domain.WebhookDeliveryPendingStatus, deliveries.delivery.Status)
+		assert.Equal(t, 0, deliveries.delivery.Attempts)
+	})
+
+	t.Run("fails delivery when webhook cannot be loaded", func(t *testing.T) {
+		other, err := domain.NewTender("Ремонт", "Описание", string(domain.TenderConstructionServiceType), "org", nil, nil, nil, executor)
+		require.NoError(t, err)
+
+		deliveries := &fakeDeliveries{}
+		require.NoError(t, Subscriber(fakeWebhooks{webhook: *webhook}, deliveries, nil)(context.Background(), other.PullEvents()[0]))
+
+		broken := NewSender(fakeWebhooks{err: assert.AnError}, deliveries, SenderConfig{
+			BatchSize:   10,
+			MaxAttempts: 2,
+		}, slog.Default())
+
+		_, err = broken.Process(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, domain.WebhookDeliveryPendingStatus, deliveries.delivery.Status)
+		assert.Equal(t, 1, deliveries.delivery.Attempts)
+		assert.Equal(t, assert.AnError.Error(), *deliveries.delivery.LastError)
+	})
+
+	t.Run("discards result after lease is lost", func(t *testing.T) {
+		// Пока идет запрос, аренда истекает и доставку выбирает другой отправитель
+		slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = deliveries.Claim(r.Context(), repositories.ClaimWebhookDeliveriesDTO{})
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer slow.Close()
+
+		other, err := domain.NewWebhook(slow.URL, []string{string(domain.TenderCreatedEvent)}, secret, executor)
+		require.NoError(t, err)
+
+		racing := NewSender(fakeWebhooks{webhook: *other}, deliveries, SenderConfig{
+			BatchSize:   10,
+			MaxAttempts: 2,
+			Timeout:     time.Second,
+
+			AllowPrivateTargets: true,
+		}, slog.Default())
+
+		require.Equal(t, domain.WebhookDeliveryPendingStatus, deliveries.delivery.Status)
+		_, err = racing.Process(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, domain.WebhookDeliveryPendingStatus, deliveries.delivery.Status)
+		assert.Equal(t, 0, deliveries.delivery.Attempts)
+	})
+}
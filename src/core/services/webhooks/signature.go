@@ -0,0 +1,33 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"tms/src/core/domain"
+)
+
+const (
+	EventHeader    = "X-Tms-Event"
+	DeliveryHeader = "X-Tms-Delivery"
+	// TimestampHeader время отправки в секундах Unix, входит в подпись, чтобы запрос нельзя было повторить позже
+	TimestampHeader = "X-Tms-Timestamp"
+	SignatureHeader = "X-Tms-Signature"
+)
+
+// Sign возвращает подпись тела запроса в формате "sha256=<hex>":
+// HMAC-SHA256 с ключом secret от строки "<timestamp>.<body>"
+func Sign(secret domain.WebhookSecret, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify проверяет подпись, полученную получателем вебхука
+func Verify(secret domain.WebhookSecret, timestamp int64, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
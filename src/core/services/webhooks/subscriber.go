@@ -0,0 +1,68 @@
+package webhooks
+
+import (
+	"context"
+	"tms/src/core/domain"
+	"tms/src/core/services/events"
+	"tms/src/core/services/repositories"
+)
+
+// Subscriber подписчик событий, создающий доставки вебхукам организации, которой принадлежит тендер события.
+// Повторный вызов для того же события не создает новых доставок
+func Subscriber(
+	webhookRepository repositories.WebhookRepository,
+	deliveryRepository repositories.WebhookDeliveryRepository,
+	tenderRepository repositories.TenderRepository,
+) events.Handler {
+	return func(ctx context.Context, event domain.Event) error {
+		organizationID, err := organizationOf(ctx, tenderRepository, event)
+		if err != nil {
+			return err
+		}
+
+		name := event.EventName()
+		webhooks, err := webhookRepository.GetList(ctx, repositories.GetWebhooksListDTO{
+			OrganizationID: organizationID,
+			Event:          &name,
+		})
+		if err != nil {
+			return err
+		}
+
+		deliveries := make([]domain.WebhookDelivery, 0, len(webhooks))
+		for _, webhook := range webhooks {
+			delivery, err := domain.NewWebhookDelivery(webhook, event)
+			if err != nil {
+				return err
+			}
+			deliveries = append(deliveries, *delivery)
+		}
+
+		return deliveryRepository.Append(ctx, deliveries...)
+	}
+}
+
+// organizationOf возвращает организацию тендера, к которому относится событие
+func organizationOf(ctx context.Context, tenderRepository repositories.TenderRepository, event domain.Event) (domain.ID, error) {
+	switch e := event.(type) {
+	case domain.TenderCreated:
+		return e.OrganizationID, nil
+	case domain.TenderEdited:
+		return e.OrganizationID, nil
+	case domain.TenderRolledBack:
+		return e.OrganizationID, nil
+	case domain.TenderStatusChanged:
+		return e.OrganizationID, nil
+	case domain.TenderCriteriaSet:
+		return e.OrganizationID, nil
+	case domain.BidScored:
+		return e.OrganizationID, nil
+	}
+
+	tender, err := tenderRepository.Get(ctx, repositories.GetTenderDTO{ID: domain.TenderOf(event)})
+	if err != nil {
+		return "", err
+	}
+
+	return tender.OrganizationID, nil
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
 )
 
@@ -16,6 +17,26 @@ func Error(msg string) ErrorResponse {
 	return ErrorResponse{msg}
 }
 
+// FieldError Нарушение требований к отдельному параметру запроса
+type FieldError struct {
+	In     string `json:"in"`
+	Field  string `json:"field,omitempty"`
+	Reason string `json:"reason"`
+}
+
+type ValidationErrorResponse struct {
+	Reason string       `json:"reason"`
+	Errors []FieldError `json:"errors"`
+}
+
+// ValidationError создает ValidationErrorResponse
+func ValidationError(msg string, errs []FieldError) ValidationErrorResponse {
+	return ValidationErrorResponse{
+		Reason: msg,
+		Errors: errs,
+	}
+}
+
 // WriteJSON записывает Response в формате JSON в http.ResponseWriter
 func WriteJSON(w http.ResponseWriter, statusCode int, resp interface{}) {
 	w.Header().Set("Content-Type", "application/json")
@@ -87,3 +108,48 @@ func ParseStringQueryParam(r *http.Request, key string) *string {
 	// Return a pointer to the queryParam string
 	return &queryParam
 }
+
+// ParseStringsQueryParam возвращает все значения параметра, переданного несколько раз (?key=a&key=b)
+func ParseStringsQueryParam(r *http.Request, key string) []string {
+	values := make([]string, 0)
+
+	for _, v := range r.URL.Query()[key] {
+		if v != "" {
+			values = append(values, v)
+		}
+	}
+
+	return values
+}
+
+func ParseBoolQueryParam(r *http.Request, key string) (*bool, error) {
+	queryParam := r.URL.Query().Get(key)
+
+	if queryParam == "" {
+		return nil, nil
+	}
+
+	value, err := strconv.ParseBool(queryParam)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bool value for %s: %v", key, err)
+	}
+
+	return &value, nil
+}
+
+// SetPaginationHeaders выставляет Link на следующую страницу (если nextCursor не пустой)
+// и X-Total-Count (если total передан)
+func SetPaginationHeaders(w http.ResponseWriter, r *http.Request, nextCursor string, total *int) {
+	if nextCursor != "" {
+		query := r.URL.Query()
+		query.Set("cursor", nextCursor)
+		query.Del("offset")
+
+		next := url.URL{Path: r.URL.Path, RawQuery: query.Encode()}
+		w.Header().Set("Link", fmt.Sprintf(`<%s>; rel="next"`, next.String()))
+	}
+
+	if total != nil {
+		w.Header().Set("X-Total-Count", strconv.Itoa(*total))
+	}
+}
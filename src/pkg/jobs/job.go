@@ -0,0 +1,75 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"time"
+)
+
+// ErrLeaseLost аренда задачи истекла, и задачу уже забрал другой экземпляр, поэтому результат не записан
+var ErrLeaseLost = errors.New("job lease lost")
+
+type Status string
+
+const (
+	// PendingStatus задача ждет наступления RunAt
+	PendingStatus Status = "pending"
+	// RunningStatus задача выполняется экземпляром, арендовавшим ее до LockedUntil
+	RunningStatus Status = "running"
+	// FailedStatus попытки задачи исчерпаны, она больше не выполняется
+	FailedStatus Status = "failed"
+)
+
+// Job Задача очереди. Выполненные разовые задачи удаляются, периодические переносятся на следующий запуск
+type Job struct {
+	ID   string `json:"id"`
+	Kind string `json:"kind"`
+	// Key уникальный ключ задачи, повторная постановка с тем же ключом игнорируется
+	Key         *string         `json:"key,omitempty"`
+	Payload     json.RawMessage `json:"payload"`
+	Status      Status          `json:"status"`
+	Attempts    int             `json:"attempts"`
+	MaxAttempts int             `json:"maxAttempts"`
+	RunAt       time.Time       `json:"runAt"`
+	LockedUntil *time.Time      `json:"lockedUntil,omitempty"`
+	LastError   *string         `json:"lastError,omitempty"`
+	CreatedAt   time.Time       `json:"createdAt"`
+	UpdatedAt   time.Time       `json:"updatedAt"`
+
+	// LockToken выдается при каждой аренде, результат записывается только по токену действующей аренды
+	LockToken *string `json:"-"`
+}
+
+// Decode разбирает Payload в v
+func (j Job) Decode(v interface{}) error {
+	return json.Unmarshal(j.Payload, v)
+}
+
+// Handler выполняет задачу. Ошибка означает неудачную попытку
+type Handler func(ctx context.Context, job Job) error
+
+type ListOptions struct {
+	// Statuses пустой список возвращает задачи во всех статусах
+	Statuses []Status
+	Limit    int
+	Offset   int
+}
+
+// Store Хранилище очереди. Все сроки отсчитываются от часов хранилища, а не экземпляра приложения
+type Store interface {
+	Enqueue(ctx context.Context, job Job, delay time.Duration) error
+	// Claim арендует на lease до limit готовых к выполнению задач вида kinds, включая задачи с истекшей арендой.
+	// Задачи, арендованные другими экземплярами, пропускаются
+	Claim(ctx context.Context, kinds []string, limit int, lease time.Duration) ([]Job, error)
+	// Complete удаляет выполненную задачу.
+	// Этот и следующие методы возвращают ErrLeaseLost, если аренда job уже не действует
+	Complete(ctx context.Context, job Job) error
+	// Retry возвращает задачу в очередь через delay, lastError сохраняется
+	Retry(ctx context.Context, job Job, delay time.Duration, lastError *string, resetAttempts bool) error
+	// Release возвращает в очередь задачу, прерванную остановкой экземпляра, не засчитывая попытку
+	Release(ctx context.Context, job Job) error
+	// Fail останавливает задачу, попытки которой исчерпаны
+	Fail(ctx context.Context, job Job, lastError string) error
+	List(ctx context.Context, opts ListOptions) ([]Job, error)
+}
@@ -0,0 +1,37 @@
+package jobs
+
+import "time"
+
+type enqueueOptions struct {
+	delay       time.Duration
+	key         *string
+	maxAttempts int
+}
+
+type Option func(o *enqueueOptions)
+
+// After откладывает выполнение задачи на delay
+func After(delay time.Duration) Option {
+	return func(o *enqueueOptions) {
+		o.delay = delay
+	}
+}
+
+// At откладывает выполнение задачи до t
+func At(t time.Time) Option {
+	return After(time.Until(t))
+}
+
+// WithKey ставит задачу, только если в очереди нет задачи с тем же ключом
+func WithKey(key string) Option {
+	return func(o *enqueueOptions) {
+		o.key = &key
+	}
+}
+
+// WithMaxAttempts переопределяет кол-во попыток из Config
+func WithMaxAttempts(n int) Option {
+	return func(o *enqueueOptions) {
+		o.maxAttempts = n
+	}
+}
@@ -0,0 +1,142 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"time"
+	"tms/src/pkg/pg"
+)
+
+// PostgresStore Хранилище очереди в таблице job. Аренда выполняется через SELECT ... FOR UPDATE SKIP LOCKED,
+// поэтому очередь безопасно разбирают несколько экземпляров приложения. Результат задачи записывается
+// только при совпадении lock_token, так что экземпляр с истекшей арендой не перезапишет чужую
+type PostgresStore struct {
+	client *pg.Client
+}
+
+func NewPostgresStore(client *pg.Client) *PostgresStore {
+	return &PostgresStore{client: client}
+}
+
+const jobColumns = `id, kind, unique_key, payload, status, attempts, max_attempts, run_at, locked_until, lock_token, last_error, created_at, updated_at`
+
+func (s *PostgresStore) Enqueue(ctx context.Context, job Job, delay time.Duration) error {
+	query := `INSERT INTO job(id, kind, unique_key, payload, status, max_attempts, run_at)
+		VALUES ($1, $2, $3, $4, $5, $6, CURRENT_TIMESTAMP + make_interval(secs => $7))
+		ON CONFLICT (unique_key) DO NOTHING`
+
+	_, err := s.client.Exec(ctx, query, job.ID, job.Kind, job.Key, job.Payload, PendingStatus, job.MaxAttempts, delay.Seconds())
+	return err
+}
+
+func (s *PostgresStore) Claim(ctx context.Context, kinds []string, limit int, lease time.Duration) ([]Job, error) {
+	query := `UPDATE job SET status = $1, attempts = attempts + 1,
+			locked_until = CURRENT_TIMESTAMP + make_interval(secs => $2), lock_token = $6, updated_at = CURRENT_TIMESTAMP
+		WHERE id IN (
+			SELECT id FROM job
+			WHERE kind = ANY($3) AND (
+				status = $4 AND run_at <= CURRENT_TIMESTAMP OR
+				status = $1 AND locked_until <= CURRENT_TIMESTAMP
+			)
+			ORDER BY run_at, id
+			LIMIT $5
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + jobColumns
+
+	return s.query(ctx, query, RunningStatus, lease.Seconds(), kinds, PendingStatus, limit, uuid.NewString())
+}
+
+// leased условие действующей аренды задачи, параметры $1 и $2 - id и lock_token задачи
+const leased = `id = $1 AND lock_token = $2 AND status = 'running'`
+
+func (s *PostgresStore) Complete(ctx context.Context, job Job) error {
+	return s.finish(ctx, `DELETE FROM job WHERE `+leased, job)
+}
+
+func (s *PostgresStore) Retry(ctx context.Context, job Job, delay time.Duration, lastError *string, resetAttempts bool) error {
+	query := `UPDATE job SET status = $3, run_at = CURRENT_TIMESTAMP + make_interval(secs => $4), locked_until = NULL,
+			lock_token = NULL, last_error = $5, attempts = CASE WHEN $6 THEN 0 ELSE attempts END, updated_at = CURRENT_TIMESTAMP
+		WHERE ` + leased
+
+	return s.finish(ctx, query, job, PendingStatus, delay.Seconds(), lastError, resetAttempts)
+}
+
+func (s *PostgresStore) Release(ctx context.Context, job Job) error {
+	query := `UPDATE job SET status = $3, locked_until = NULL, lock_token = NULL, attempts = GREATEST(attempts - 1, 0),
+			updated_at = CURRENT_TIMESTAMP
+		WHERE ` + leased
+
+	return s.finish(ctx, query, job, PendingStatus)
+}
+
+func (s *PostgresStore) Fail(ctx context.Context, job Job, lastError string) error {
+	query := `UPDATE job SET status = $3, locked_until = NULL, lock_token = NULL, last_error = $4, updated_at = CURRENT_TIMESTAMP
+		WHERE ` + leased
+
+	return s.finish(ctx, query, job, FailedStatus, lastError)
+}
+
+// finish выполняет query по аренде job и возвращает ErrLeaseLost, если аренда уже не действует
+func (s *PostgresStore) finish(ctx context.Context, query string, job Job, args ...interface{}) error {
+	tag, err := s.client.Exec(ctx, query, append([]interface{}{job.ID, job.LockToken}, args...)...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrLeaseLost
+	}
+
+	return nil
+}
+
+func (s *PostgresStore) List(ctx context.Context, opts ListOptions) ([]Job, error) {
+	query := `SELECT ` + jobColumns + ` FROM job WHERE 1=1`
+	args := make([]interface{}, 0)
+	i := 1
+
+	if len(opts.Statuses) > 0 {
+		query += fmt.Sprintf(` AND status = ANY($%d)`, i)
+		args = append(args, pg.StringArray(opts.Statuses))
+		i++
+	}
+
+	query += fmt.Sprintf(` ORDER BY run_at, id LIMIT $%d OFFSET $%d`, i, i+1)
+	args = append(args, opts.Limit, opts.Offset)
+
+	return s.query(ctx, query, args...)
+}
+
+func (s *PostgresStore) query(ctx context.Context, query string, args ...interface{}) ([]Job, error) {
+	rows, err := s.client.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	jobs := make([]Job, 0)
+
+	for rows.Next() {
+		job, err := scanJob(rows)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, *job)
+	}
+
+	return jobs, rows.Err()
+}
+
+func scanJob(row pgx.Row) (*Job, error) {
+	var job Job
+
+	err := row.Scan(&job.ID, &job.Kind, &job.Key, &job.Payload, &job.Status, &job.Attempts, &job.MaxAttempts,
+		&job.RunAt, &job.LockedUntil, &job.LockToken, &job.LastError, &job.CreatedAt, &job.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
@@ -0,0 +1,265 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"log/slog"
+	"sync"
+	"time"
+	"tms/src/pkg/logger/sl"
+)
+
+type Config struct {
+	PollInterval time.Duration
+	// Concurrency кол-во задач, одновременно выполняемых экземпляром
+	Concurrency int
+	// Lease время аренды задачи. Задачу, не завершенную за это время, может забрать другой экземпляр,
+	// поэтому контекст обработчика отменяется по его истечении
+	Lease       time.Duration
+	MaxAttempts int
+	// BaseBackoff задержка после первой неудачной попытки, далее удваивается до MaxBackoff
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+}
+
+// storeTimeout ограничивает запись результата задачи, которая выполняется и после отмены контекстов
+const storeTimeout = 10 * time.Second
+
+// Runner Выполняет задачи из Store. Несколько экземпляров приложения могут разбирать одну очередь
+type Runner struct {
+	store    Store
+	cfg      Config
+	log      *slog.Logger
+	handlers map[string]Handler
+	periodic map[string]time.Duration
+
+	// jobsCtx родительский контекст обработчиков, отменяется только в Shutdown
+	jobsCtx    context.Context
+	cancelJobs context.CancelFunc
+	inFlight   sync.WaitGroup
+	slots      chan struct{}
+	stopped    chan struct{}
+	stopOnce   sync.Once
+}
+
+func NewRunner(store Store, cfg Config, log *slog.Logger) *Runner {
+	jobsCtx, cancelJobs := context.WithCancel(context.Background())
+
+	return &Runner{
+		store:      store,
+		cfg:        cfg,
+		log:        log,
+		handlers:   make(map[string]Handler),
+		periodic:   make(map[string]time.Duration),
+		jobsCtx:    jobsCtx,
+		cancelJobs: cancelJobs,
+		slots:      make(chan struct{}, max(cfg.Concurrency, 1)),
+		stopped:    make(chan struct{}),
+	}
+}
+
+// Register регистрирует обработчик задач вида kind. Вызывается до Run
+func (r *Runner) Register(kind string, handler Handler) {
+	r.handlers[kind] = handler
+}
+
+// Every регистрирует периодическую задачу. В очереди хранится одна задача с ключом kind,
+// поэтому во всех экземплярах приложения она выполняется не чаще, чем раз в interval
+func (r *Runner) Every(kind string, interval time.Duration, handler Handler) {
+	r.handlers[kind] = handler
+	r.periodic[kind] = interval
+}
+
+// Enqueue ставит задачу вида kind в очередь
+func (r *Runner) Enqueue(ctx context.Context, kind string, payload interface{}, opts ...Option) error {
+	o := enqueueOptions{maxAttempts: r.cfg.MaxAttempts}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	job := Job{
+		ID:          uuid.New().String(),
+		Kind:        kind,
+		Key:         o.key,
+		Payload:     data,
+		MaxAttempts: o.maxAttempts,
+	}
+
+	return r.store.Enqueue(ctx, job, max(o.delay, 0))
+}
+
+// Run выбирает задачи до отмены ctx или вызова Shutdown. Отмена ctx не прерывает уже выполняемые задачи
+func (r *Runner) Run(ctx context.Context) {
+	for kind := range r.periodic {
+		if err := r.Enqueue(ctx, kind, struct{}{}, WithKey(kind)); err != nil {
+			r.log.Error("cannot enqueue periodic job", slog.String("kind", kind), sl.Err(err))
+		}
+	}
+
+	ticker := time.NewTicker(r.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		n, err := r.Poll(ctx)
+		if err != nil && ctx.Err() == nil {
+			r.log.Error("job runner failed", sl.Err(err))
+		}
+
+		// Все слоты заняты, поэтому следующая пачка выбирается, как только освободится слот
+		if err == nil && n > 0 && n == cap(r.slots) {
+			select {
+			case <-ctx.Done():
+				return
+			case <-r.stopped:
+				return
+			case r.slots <- struct{}{}:
+				<-r.slots
+			}
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-r.stopped:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll арендует задачи на свободные слоты, запускает их и возвращает кол-во занятых слотов
+func (r *Runner) Poll(ctx context.Context) (int, error) {
+	select {
+	case <-r.stopped:
+		return 0, nil
+	default:
+	}
+
+	free := cap(r.slots) - len(r.slots)
+	if free == 0 || len(r.handlers) == 0 {
+		return len(r.slots), nil
+	}
+
+	kinds := make([]string, 0, len(r.handlers))
+	for kind := range r.handlers {
+		kinds = append(kinds, kind)
+	}
+
+	jobs, err := r.store.Claim(ctx, kinds, free, r.cfg.Lease)
+	if err != nil {
+		return len(r.slots), err
+	}
+
+	for _, job := range jobs {
+		r.slots <- struct{}{}
+		r.inFlight.Add(1)
+		go r.execute(job)
+	}
+
+	return len(r.slots), nil
+}
+
+// Shutdown прекращает выбор задач и ждет завершения выполняемых.
+// Если ctx отменяется раньше, выполняемые задачи прерываются и возвращаются в очередь
+func (r *Runner) Shutdown(ctx context.Context) error {
+	r.stopOnce.Do(func() { close(r.stopped) })
+
+	done := make(chan struct{})
+	go func() {
+		r.inFlight.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		r.cancelJobs()
+		return nil
+	case <-ctx.Done():
+		r.cancelJobs()
+		<-done
+		return ctx.Err()
+	}
+}
+
+func (r *Runner) execute(job Job) {
+	defer func() {
+		<-r.slots
+		r.inFlight.Done()
+	}()
+
+	log := r.log.With(slog.String("jobId", job.ID), slog.String("kind", job.Kind), slog.Int("attempt", job.Attempts))
+
+	ctx, cancel := context.WithTimeout(r.jobsCtx, r.cfg.Lease)
+	err := r.handle(ctx, job)
+	cancel()
+
+	storeCtx, storeCancel := context.WithTimeout(context.Background(), storeTimeout)
+	defer storeCancel()
+
+	if err := r.finish(storeCtx, job, err); err != nil {
+		if errors.Is(err, ErrLeaseLost) {
+			log.Warn("job lease expired before result was saved")
+			return
+		}
+		log.Error("cannot save job result", sl.Err(err))
+		return
+	}
+	if err != nil {
+		log.Error("job failed", sl.Err(err))
+	}
+}
+
+func (r *Runner) handle(ctx context.Context, job Job) (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("job panicked: %v", p)
+		}
+	}()
+
+	return r.handlers[job.Kind](ctx, job)
+}
+
+func (r *Runner) finish(ctx context.Context, job Job, err error) error {
+	interval, periodic := r.periodic[job.Kind]
+
+	if err != nil && r.jobsCtx.Err() != nil {
+		return r.store.Release(ctx, job)
+	}
+
+	if err == nil {
+		if periodic {
+			return r.store.Retry(ctx, job, interval, nil, true)
+		}
+		return r.store.Complete(ctx, job)
+	}
+
+	lastError := err.Error()
+
+	if job.Attempts < job.MaxAttempts {
+		return r.store.Retry(ctx, job, backoff(job.Attempts, r.cfg.BaseBackoff, r.cfg.MaxBackoff), &lastError, false)
+	}
+	// Периодическая задача не останавливается, а пропускает запуск
+	if periodic {
+		return r.store.Retry(ctx, job, interval, &lastError, true)
+	}
+
+	return r.store.Fail(ctx, job, lastError)
+}
+
+func backoff(attempt int, base, max time.Duration) time.Duration {
+	delay := base
+	for i := 1; i < attempt && delay < max; i++ {
+		delay *= 2
+	}
+
+	return min(delay, max)
+}
@@ -0,0 +1,151 @@
+package jobs
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+)
+
+type result struct {
+	action    string
+	delay     time.Duration
+	lastError *string
+	reset     bool
+}
+
+type fakeStore struct {
+	mu      sync.Mutex
+	jobs    []Job
+	results map[string]result
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{results: make(map[string]result)}
+}
+
+func (f *fakeStore) Enqueue(_ context.Context, job Job, _ time.Duration) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.jobs = append(f.jobs, job)
+	return nil
+}
+
+func (f *fakeStore) Claim(_ context.Context, _ []string, limit int, _ time.Duration) ([]Job, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	n := min(limit, len(f.jobs))
+	claimed := f.jobs[:n]
+	f.jobs = f.jobs[n:]
+	for i := range claimed {
+		claimed[i].Attempts++
+	}
+	return claimed, nil
+}
+
+func (f *fakeStore) set(id string, r result) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.results[id] = r
+	return nil
+}
+
+func (f *fakeStore) Complete(_ context.Context, job Job) error {
+	return f.set(job.ID, result{action: "complete"})
+}
+
+func (f *fakeStore) Retry(_ context.Context, job Job, delay time.Duration, lastError *string, reset bool) error {
+	return f.set(job.ID, result{action: "retry", delay: delay, lastError: lastError, reset: reset})
+}
+
+func (f *fakeStore) Release(_ context.Context, job Job) error {
+	return f.set(job.ID, result{action: "release"})
+}
+
+func (f *fakeStore) Fail(_ context.Context, job Job, lastError string) error {
+	return f.set(job.ID, result{action: "fail", lastError: &lastError})
+}
+
+func (f *fakeStore) List(context.Context, ListOptions) ([]Job, error) {
+	return f.jobs, nil
+}
+
+func newTestRunner(store Store) *Runner {
+	return NewRunner(store, Config{
+		PollInterval: time.Second,
+		Concurrency:  4,
+		Lease:        time.Minute,
+		MaxAttempts:  2,
+		BaseBackoff:  time.Second,
+		MaxBackoff:   time.Minute,
+	}, slog.Default())
+}
+
+func TestRunner(t *testing.T) {
+	store := newFakeStore()
+	runner := newTestRunner(store)
+
+	runner.Register("ok", func(context.Context, Job) error { return nil })
+	runner.Register("broken", func(context.Context, Job) error { return errors.New("boom") })
+	runner.Every("periodic", time.Hour, func(context.Context, Job) error { return nil })
+
+	ctx := context.Background()
+	require.NoError(t, runner.Enqueue(ctx, "ok", nil))
+	require.NoError(t, runner.Enqueue(ctx, "broken", nil))
+	require.NoError(t, runner.Enqueue(ctx, "periodic", nil, WithKey("periodic")))
+	store.jobs = append(store.jobs, Job{ID: "exhausted", Kind: "broken", Attempts: 1, MaxAttempts: 2})
+	ids := []string{store.jobs[0].ID, store.jobs[1].ID, store.jobs[2].ID}
+
+	_, err := runner.Poll(ctx)
+	require.NoError(t, err)
+	require.NoError(t, runner.Shutdown(ctx))
+
+	assert.Equal(t, "complete", store.results[ids[0]].action)
+
+	assert.Equal(t, "retry", store.results[ids[1]].action)
+	assert.Equal(t, time.Second, store.results[ids[1]].delay)
+	assert.Equal(t, "boom", *store.results[ids[1]].lastError)
+
+	assert.Equal(t, result{action: "retry", delay: time.Hour, reset: true}, store.results[ids[2]])
+
+	assert.Equal(t, "fail", store.results["exhausted"].action)
+}
+
+func TestRunnerShutdown(t *testing.T) {
+	store := newFakeStore()
+	runner := newTestRunner(store)
+
+	started := make(chan struct{})
+	runner.Register("slow", func(ctx context.Context, _ Job) error {
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	require.NoError(t, runner.Enqueue(context.Background(), "slow", nil))
+	id := store.jobs[0].ID
+
+	_, err := runner.Poll(context.Background())
+	require.NoError(t, err)
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	assert.ErrorIs(t, runner.Shutdown(ctx), context.DeadlineExceeded)
+	assert.Equal(t, "release", store.results[id].action)
+
+	n, err := runner.Poll(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, n)
+}
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, time.Second, backoff(1, time.Second, time.Minute))
+	assert.Equal(t, 4*time.Second, backoff(3, time.Second, time.Minute))
+	assert.Equal(t, time.Minute, backoff(10, time.Second, time.Minute))
+}
@@ -0,0 +1,15 @@
+package mailer
+
+import "context"
+
+// Message Письмо в формате text/plain
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer Отправитель писем
+type Mailer interface {
+	Send(ctx context.Context, message Message) error
+}
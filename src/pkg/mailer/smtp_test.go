@@ -0,0 +1,143 @@
+package mailer
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+)
+
+// received Письмо, принятое fakeSMTP
+type received struct {
+	from string
+	to   []string
+	data string
+}
+
+// fakeSMTP Минимальный SMTP сервер без STARTTLS и аутентификации
+func fakeSMTP(t *testing.T) (string, int, <-chan received) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ln.Close() })
+
+	messages := make(chan received, 1)
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go serveSMTP(conn, messages)
+		}
+	}()
+
+	addr := ln.Addr().(*net.TCPAddr)
+	return addr.IP.String(), addr.Port, messages
+}
+
+func serveSMTP(conn net.Conn, messages chan<- received) {
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = fmt.Fprintf(conn, "%s\r\n", line) }
+
+	var m received
+	reply("220 fake ESMTP")
+
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		command := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+
+		switch command {
+		case "EHLO", "HELO":
+			reply("250 fake")
+		case "MAIL":
+			m = received{from: strings.TrimPrefix(line, "MAIL FROM:")}
+			reply("250 OK")
+		case "RCPT":
+			m.to = append(m.to, strings.TrimPrefix(line, "RCPT TO:"))
+			reply("250 OK")
+		case "DATA":
+			reply("354 end with .")
+			var data strings.Builder
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if l == ".\r\n" {
+					break
+				}
+				data.WriteString(strings.TrimPrefix(l, "."))
+			}
+			m.data = data.String()
+			messages <- m
+			reply("250 OK")
+		case "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 not implemented")
+		}
+	}
+}
+
+func TestSMTP_Send(t *testing.T) {
+	host, port, messages := fakeSMTP(t)
+
+	sender, err := NewSMTP(SMTPConfig{Host: host, Port: port, From: "TMS <noreply@tms.local>"})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err = sender.Send(ctx, Message{
+		To:      "user1@tms.local",
+		Subject: "Тендер «Доставка» закрыт",
+		Body:    "Здравствуйте!\nТендер закрыт.",
+	})
+	require.NoError(t, err)
+
+	m := <-messages
+	assert.Equal(t, "<noreply@tms.local>", m.from)
+	assert.Equal(t, []string{"<user1@tms.local>"}, m.to)
+
+	msg, err := mail.ReadMessage(strings.NewReader(m.data))
+	require.NoError(t, err)
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "Тендер «Доставка» закрыт", subject)
+	assert.Equal(t, "text/plain; charset=utf-8", msg.Header.Get("Content-Type"))
+
+	body, err := io.ReadAll(quotedprintable.NewReader(msg.Body))
+	require.NoError(t, err)
+	assert.Equal(t, "Здравствуйте!\r\nТендер закрыт.", strings.TrimRight(string(body), "\r\n"))
+}
+
+func TestSMTP_SendUnreachable(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := ln.Addr().(*net.TCPAddr).Port
+	require.NoError(t, ln.Close())
+
+	sender, err := NewSMTP(SMTPConfig{Host: "127.0.0.1", Port: port, From: "noreply@tms.local"})
+	require.NoError(t, err)
+
+	err = sender.Send(context.Background(), Message{To: "user1@tms.local", Subject: "s", Body: "b"})
+	assert.Error(t, err)
+}
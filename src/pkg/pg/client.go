@@ -24,6 +24,23 @@ type Client struct {
 	log *slog.Logger
 }
 
+// querier общие методы пула и транзакции
+type querier interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+type txKey struct{}
+
+// conn возвращает транзакцию из ctx, если запрос выполняется внутри WithinTransaction, иначе пул
+func (p *Client) conn(ctx context.Context) querier {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx
+	}
+	return p.db
+}
+
 // New создает новый экземпляр Client
 func New(log *slog.Logger, cfg Config) (*Client, error) {
 	const op = "Client.New"
@@ -95,7 +112,7 @@ func (p *Client) AutoMigrate(migrationsPath string) error {
 func (p *Client) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
 	start := time.Now()
 
-	result, err := p.db.Exec(ctx, sql, args...)
+	result, err := p.conn(ctx).Exec(ctx, sql, args...)
 	duration := time.Since(start)
 
 	if err != nil {
@@ -117,7 +134,7 @@ func (p *Client) Exec(ctx context.Context, sql string, args ...interface{}) (pgc
 func (p *Client) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
 	start := time.Now()
 
-	rows, err := p.db.Query(ctx, sql, args...)
+	rows, err := p.conn(ctx).Query(ctx, sql, args...)
 	duration := time.Since(start)
 
 	if err != nil {
@@ -139,19 +156,28 @@ func (p *Client) Query(ctx context.Context, sql string, args ...interface{}) (pg
 func (p *Client) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
 	start := time.Now()
 
-	row := p.db.QueryRow(ctx, sql, args...)
+	row := p.conn(ctx).QueryRow(ctx, sql, args...)
 	duration := time.Since(start)
 
 	p.log.Info("Query executed", slog.String("query", formatSQLQuery(sql)), slog.Any("args", args), slog.Duration("duration", duration))
 	return row
 }
 
-// BeginTx начинает транзакцию
+// BeginTx начинает транзакцию. Внутри WithinTransaction создается вложенная транзакция (savepoint),
+// поэтому ее Commit не фиксирует внешнюю транзакцию
 func (p *Client) BeginTx(ctx context.Context, opts pgx.TxOptions) (pgx.Tx, error) {
 	start := time.Now()
 	p.log.Info("Beginning transaction")
 
-	tx, err := p.db.BeginTx(ctx, opts)
+	var (
+		tx  pgx.Tx
+		err error
+	)
+	if outer, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		tx, err = outer.Begin(ctx)
+	} else {
+		tx, err = p.db.BeginTx(ctx, opts)
+	}
 	if err != nil {
 		p.log.Error("Error beginning transaction", slog.Duration("duration", time.Since(start)), sl.Err(err))
 		return nil, err
@@ -161,8 +187,68 @@ func (p *Client) BeginTx(ctx context.Context, opts pgx.TxOptions) (pgx.Tx, error
 	return tx, nil
 }
 
+// WithinTransaction выполняет fn в одной транзакции: все запросы Client с переданным в fn ctx
+// попадают в нее. Транзакция фиксируется, только если fn не вернула ошибку
+func (p *Client) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := p.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// Notify отправляет уведомление payload в канал channel.
+// Внутри WithinTransaction уведомление получат только после фиксации транзакции
+func (p *Client) Notify(ctx context.Context, channel, payload string) error {
+	_, err := p.Exec(ctx, `SELECT pg_notify($1, $2)`, channel, payload)
+	return err
+}
+
+// Listen подписывается на канал channel и вызывает handle для каждого уведомления до отмены ctx или разрыва соединения.
+// Для подписки занимается отдельное соединение, которое не возвращается в пул
+func (p *Client) Listen(ctx context.Context, channel string, handle func(payload string)) error {
+	pooled, err := p.db.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	conn := pooled.Hijack()
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{channel}.Sanitize()); err != nil {
+		return err
+	}
+	p.log.Info("Listening for notifications", slog.String("channel", channel))
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		handle(notification.Payload)
+	}
+}
+
 // formatSQLQuery форматирует SQL запрос для лучшей читаемости в логах
 func formatSQLQuery(query string) string {
 	query = strings.ReplaceAll(query, "\n", " ")
 	return strings.ReplaceAll(query, "\r", " ")
 }
+
+// StringArray приводит срез строковых типов к []string, который pgx умеет передавать как text[]
+func StringArray[T ~string](values []T) []string {
+	result := make([]string, len(values))
+	for i, v := range values {
+		result[i] = string(v)
+	}
+	return result
+}
@@ -0,0 +1,37 @@
+package pg
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Query SQL запрос, параметры которого нумеруются в порядке добавления аргументов
+type Query struct {
+	sql  strings.Builder
+	args []interface{}
+}
+
+func NewQuery(sql string) *Query {
+	q := &Query{}
+	q.sql.WriteString(sql)
+	return q
+}
+
+// Add дописывает фрагмент к тексту запроса
+func (q *Query) Add(sql string) {
+	q.sql.WriteString(sql)
+}
+
+// Arg добавляет аргумент и возвращает его параметр вида $n
+func (q *Query) Arg(value interface{}) string {
+	q.args = append(q.args, value)
+	return "$" + strconv.Itoa(len(q.args))
+}
+
+func (q *Query) SQL() string {
+	return q.sql.String()
+}
+
+func (q *Query) Args() []interface{} {
+	return q.args
+}
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"github.com/pkg/errors"
+	"log/slog"
+	"net/http"
+	"tms/src/core/domain"
+	usecases "tms/src/core/services/use-cases/audit"
+	"tms/src/pkg/api"
+	"tms/src/pkg/logger/sl"
+)
+
+func NewGetAuditLogHandler(logger slog.Logger, uc usecases.GetAuditLogUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		op := "GetAuditLogHandler"
+		l := logger.With("op", op)
+
+		limit, err := api.ParseIntQueryParam(r, "limit")
+		if err != nil {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+			l.Error("invalid limit", sl.Err(err))
+			return
+		}
+
+		offset, err := api.ParseIntQueryParam(r, "offset")
+		if err != nil {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+			l.Error("invalid offset", sl.Err(err))
+			return
+		}
+
+		username := r.URL.Query().Get("username")
+		if username == "" {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("missing username"))
+			return
+		}
+
+		dto := usecases.GetAuditLogDTO{
+			Limit:       limit,
+			Offset:      offset,
+			Username:    username,
+			EntityType:  api.ParseStringQueryParam(r, "entity_type"),
+			EntityID:    api.ParseStringQueryParam(r, "entity_id"),
+			Action:      api.ParseStringQueryParam(r, "action"),
+			ActorID:     api.ParseStringQueryParam(r, "actor_id"),
+			CreatedFrom: api.ParseStringQueryParam(r, "created_from"),
+			CreatedTo:   api.ParseStringQueryParam(r, "created_to"),
+			Cursor:      api.ParseStringQueryParam(r, "cursor"),
+		}
+		log := l.With("dto", dto)
+		page, err := uc.Execute(dto)
+
+		if err != nil {
+			if errors.Is(errors.Cause(err), domain.ErrValidation) {
+				api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+				log.Error("validation failed", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrNotFound) {
+				api.WriteJSON(w, http.StatusForbidden, api.Error(err.Error()))
+				log.Error("employee is not organization responsible", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrNoPermission) {
+				api.WriteJSON(w, http.StatusForbidden, api.Error(err.Error()))
+				log.Error("permission denied", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrUserNotFound) {
+				api.WriteJSON(w, http.StatusUnauthorized, api.Error(err.Error()))
+				log.Error("user not found", sl.Err(err))
+				return
+			}
+			api.WriteJSON(w, http.StatusInternalServerError, api.Error("Internal server error"))
+			log.Error("internal server error", sl.Err(err))
+			return
+		}
+
+		api.SetPaginationHeaders(w, r, page.NextToken(), page.Total)
+		api.WriteJSON(w, http.StatusOK, page.Items)
+	}
+}
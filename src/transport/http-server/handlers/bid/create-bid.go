@@ -5,12 +5,13 @@ import (
 	"log/slog"
 	"net/http"
 	"tms/src/core/domain"
+	"tms/src/core/services"
 	usecases "tms/src/core/services/use-cases/bid"
 	"tms/src/pkg/api"
 	"tms/src/pkg/logger/sl"
 )
 
-func NewCreateBidHandler(logger slog.Logger, createBidUseCase usecases.CreateBidUseCase) http.HandlerFunc {
+func NewCreateBidHandler(logger slog.Logger, createBidUseCase services.UseCase[usecases.CreateBidDTO, *domain.Bid]) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		op := "CreateBidHandler"
 		log := logger.With("op", op)
@@ -23,7 +24,7 @@ func NewCreateBidHandler(logger slog.Logger, createBidUseCase usecases.CreateBid
 		}
 		log = log.With("body", body)
 
-		bid, err := createBidUseCase.Execute(*body)
+		bid, err := createBidUseCase.Execute(r.Context(), *body)
 		if err != nil {
 			if errors.Is(errors.Cause(err), domain.ErrValidation) {
 				api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
@@ -5,17 +5,19 @@ import (
 	"log/slog"
 	"net/http"
 	"tms/src/core/domain"
+	"tms/src/core/services"
 	usecases "tms/src/core/services/use-cases/bid"
 	"tms/src/pkg/api"
 	"tms/src/pkg/logger/sl"
 )
 
 type EditBidHandlerBody struct {
-	Name        *string `json:"name"`
-	Description *string `json:"description"`
+	Name        *string       `json:"name"`
+	Description *string       `json:"description"`
+	Price       *domain.Money `json:"price"`
 }
 
-func NewEditBidHandler(logger slog.Logger, uc usecases.EditBidUseCase) http.HandlerFunc {
+func NewEditBidHandler(logger slog.Logger, uc services.UseCase[usecases.EditBidDTO, *domain.Bid]) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		bidID := r.PathValue("bidId")
 		if bidID == "" {
@@ -43,10 +45,11 @@ func NewEditBidHandler(logger slog.Logger, uc usecases.EditBidUseCase) http.Hand
 			Username:    username,
 			Name:        body.Name,
 			Description: body.Description,
+			Price:       body.Price,
 		}
 		log := logger.With("dto", dto)
 
-		bid, err := uc.Execute(dto)
+		bid, err := uc.Execute(r.Context(), dto)
 		if err != nil {
 			if errors.Is(errors.Cause(err), domain.ErrValidation) {
 				api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
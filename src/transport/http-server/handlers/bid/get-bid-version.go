@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"github.com/pkg/errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"tms/src/core/domain"
+	usecases "tms/src/core/services/use-cases/bid"
+	"tms/src/pkg/api"
+	"tms/src/pkg/logger/sl"
+)
+
+func NewGetBidVersionHandler(logger slog.Logger, uc usecases.GetBidVersionUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		op := "GetBidVersionHandler"
+		l := logger.With("op", op)
+
+		bidID := r.PathValue("bidId")
+		if bidID == "" {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("missing bidId"))
+			return
+		}
+
+		version, err := strconv.Atoi(r.PathValue("version"))
+		if err != nil {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("version should be a number"))
+			l.Error("invalid version", sl.Err(err))
+			return
+		}
+
+		username := r.URL.Query().Get("username")
+		if username == "" {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("missing username"))
+			return
+		}
+
+		dto := usecases.GetBidVersionDTO{
+			BidID:    bidID,
+			Version:  version,
+			Username: username,
+		}
+		log := l.With("dto", dto)
+		snapshot, err := uc.Execute(dto)
+
+		if err != nil {
+			if errors.Is(errors.Cause(err), domain.ErrValidation) {
+				api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+				log.Error("validation failed", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrNotFound) {
+				api.WriteJSON(w, http.StatusNotFound, api.Error(err.Error()))
+				log.Error("some entity not found", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrNoPermission) {
+				api.WriteJSON(w, http.StatusForbidden, api.Error(err.Error()))
+				log.Error("permission denied", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrUserNotFound) {
+				api.WriteJSON(w, http.StatusUnauthorized, api.Error(err.Error()))
+				log.Error("user not found", sl.Err(err))
+				return
+			}
+			api.WriteJSON(w, http.StatusInternalServerError, api.Error("Internal server error"))
+			log.Error("internal server error", sl.Err(err))
+			return
+		}
+
+		api.WriteJSON(w, http.StatusOK, snapshot)
+	}
+}
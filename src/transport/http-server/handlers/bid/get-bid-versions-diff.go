@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"github.com/pkg/errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"tms/src/core/domain"
+	usecases "tms/src/core/services/use-cases/bid"
+	"tms/src/pkg/api"
+	"tms/src/pkg/logger/sl"
+)
+
+func NewGetBidVersionsDiffHandler(logger slog.Logger, uc usecases.GetBidVersionsDiffUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		op := "GetBidVersionsDiffHandler"
+		l := logger.With("op", op)
+
+		bidID := r.PathValue("bidId")
+		if bidID == "" {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("missing bidId"))
+			return
+		}
+
+		from, err := strconv.Atoi(r.PathValue("version"))
+		if err != nil {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("version should be a number"))
+			l.Error("invalid version", sl.Err(err))
+			return
+		}
+
+		to, err := strconv.Atoi(r.PathValue("targetVersion"))
+		if err != nil {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("targetVersion should be a number"))
+			l.Error("invalid targetVersion", sl.Err(err))
+			return
+		}
+
+		username := r.URL.Query().Get("username")
+		if username == "" {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("missing username"))
+			return
+		}
+
+		dto := usecases.GetBidVersionsDiffDTO{
+			BidID:    bidID,
+			From:     from,
+			To:       to,
+			Username: username,
+		}
+		log := l.With("dto", dto)
+		diff, err := uc.Execute(dto)
+
+		if err != nil {
+			if errors.Is(errors.Cause(err), domain.ErrValidation) {
+				api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+				log.Error("validation failed", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrNotFound) {
+				api.WriteJSON(w, http.StatusNotFound, api.Error(err.Error()))
+				log.Error("some entity not found", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrNoPermission) {
+				api.WriteJSON(w, http.StatusForbidden, api.Error(err.Error()))
+				log.Error("permission denied", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrUserNotFound) {
+				api.WriteJSON(w, http.StatusUnauthorized, api.Error(err.Error()))
+				log.Error("user not found", sl.Err(err))
+				return
+			}
+			api.WriteJSON(w, http.StatusInternalServerError, api.Error("Internal server error"))
+			log.Error("internal server error", sl.Err(err))
+			return
+		}
+
+		api.WriteJSON(w, http.StatusOK, diff)
+	}
+}
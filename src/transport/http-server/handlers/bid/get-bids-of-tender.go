@@ -29,16 +29,54 @@ func NewGetBidsOfTender(logger slog.Logger, getBidsOfTenderUseCase usecases.GetB
 			return
 		}
 
-		limit, _ := api.ParseIntQueryParam(r, "limit")
-		offset, _ := api.ParseIntQueryParam(r, "offset")
+		limit, err := api.ParseIntQueryParam(r, "limit")
+		if err != nil {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+			log.Error("invalid limit", sl.Err(err))
+			return
+		}
+
+		offset, err := api.ParseIntQueryParam(r, "offset")
+		if err != nil {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+			log.Error("invalid offset", sl.Err(err))
+			return
+		}
+
+		includeTotal, err := api.ParseBoolQueryParam(r, "include_total")
+		if err != nil {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+			log.Error("invalid include_total", sl.Err(err))
+			return
+		}
+
+		normalizePrice, err := api.ParseBoolQueryParam(r, "normalize_price")
+		if err != nil {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+			log.Error("invalid normalize_price", sl.Err(err))
+			return
+		}
 
 		dto := usecases.GetBidsOfTenderDTO{
-			TenderID: tenderID,
-			Username: username,
-			Limit:    limit,
-			Offset:   offset,
+			TenderID:       tenderID,
+			Username:       username,
+			Limit:          limit,
+			Offset:         offset,
+			Statuses:       api.ParseStringsQueryParam(r, "status"),
+			Query:          api.ParseStringQueryParam(r, "q"),
+			CreatedFrom:    api.ParseStringQueryParam(r, "created_from"),
+			CreatedTo:      api.ParseStringQueryParam(r, "created_to"),
+			UpdatedSince:   api.ParseStringQueryParam(r, "updated_since"),
+			PriceCurrency:  api.ParseStringQueryParam(r, "price_currency"),
+			MinPrice:       api.ParseStringQueryParam(r, "price_min"),
+			MaxPrice:       api.ParseStringQueryParam(r, "price_max"),
+			NormalizePrice: normalizePrice != nil && *normalizePrice,
+			SortBy:         api.ParseStringQueryParam(r, "sort_by"),
+			SortOrder:      api.ParseStringQueryParam(r, "sort_order"),
+			Cursor:         api.ParseStringQueryParam(r, "cursor"),
+			IncludeTotal:   includeTotal != nil && *includeTotal,
 		}
-		bids, err := getBidsOfTenderUseCase.Execute(dto)
+		page, err := getBidsOfTenderUseCase.Execute(dto)
 		if err != nil {
 			if errors.Is(errors.Cause(err), domain.ErrValidation) {
 				api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
@@ -70,6 +108,7 @@ func NewGetBidsOfTender(logger slog.Logger, getBidsOfTenderUseCase usecases.GetB
 			return
 		}
 
-		api.WriteJSON(w, http.StatusOK, bids)
+		api.SetPaginationHeaders(w, r, page.NextToken(), page.Total)
+		api.WriteJSON(w, http.StatusOK, page.Items)
 	}
 }
@@ -15,8 +15,34 @@ func NewGetUserBidsHandler(logger slog.Logger, getUserBidsUseCase usecases.GetUs
 		op := "GetUserBidsHandlers"
 		log := logger.With("op", op)
 
-		limit, _ := api.ParseIntQueryParam(r, "limit")
-		offset, _ := api.ParseIntQueryParam(r, "offset")
+		limit, err := api.ParseIntQueryParam(r, "limit")
+		if err != nil {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+			log.Error("invalid limit", sl.Err(err))
+			return
+		}
+
+		offset, err := api.ParseIntQueryParam(r, "offset")
+		if err != nil {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+			log.Error("invalid offset", sl.Err(err))
+			return
+		}
+
+		includeTotal, err := api.ParseBoolQueryParam(r, "include_total")
+		if err != nil {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+			log.Error("invalid include_total", sl.Err(err))
+			return
+		}
+
+		normalizePrice, err := api.ParseBoolQueryParam(r, "normalize_price")
+		if err != nil {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+			log.Error("invalid normalize_price", sl.Err(err))
+			return
+		}
+
 		username := r.URL.Query().Get("username")
 		if username == "" {
 			api.WriteJSON(w, http.StatusBadRequest, "username is required")
@@ -24,11 +50,26 @@ func NewGetUserBidsHandler(logger slog.Logger, getUserBidsUseCase usecases.GetUs
 			return
 		}
 		dto := usecases.GetUserBidsDTO{
-			Limit:    limit,
-			Offset:   offset,
-			Username: username,
+			Limit:           limit,
+			Offset:          offset,
+			Username:        username,
+			Statuses:        api.ParseStringsQueryParam(r, "status"),
+			ServiceTypes:    api.ParseStringsQueryParam(r, "service_type"),
+			OrganizationIDs: api.ParseStringsQueryParam(r, "organization_id"),
+			Query:           api.ParseStringQueryParam(r, "q"),
+			CreatedFrom:     api.ParseStringQueryParam(r, "created_from"),
+			CreatedTo:       api.ParseStringQueryParam(r, "created_to"),
+			UpdatedSince:    api.ParseStringQueryParam(r, "updated_since"),
+			PriceCurrency:   api.ParseStringQueryParam(r, "price_currency"),
+			MinPrice:        api.ParseStringQueryParam(r, "price_min"),
+			MaxPrice:        api.ParseStringQueryParam(r, "price_max"),
+			NormalizePrice:  normalizePrice != nil && *normalizePrice,
+			SortBy:          api.ParseStringQueryParam(r, "sort_by"),
+			SortOrder:       api.ParseStringQueryParam(r, "sort_order"),
+			Cursor:          api.ParseStringQueryParam(r, "cursor"),
+			IncludeTotal:    includeTotal != nil && *includeTotal,
 		}
-		bids, err := getUserBidsUseCase.Execute(dto)
+		page, err := getUserBidsUseCase.Execute(dto)
 		if err != nil {
 			if errors.Is(errors.Cause(err), domain.ErrValidation) {
 				api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
@@ -60,6 +101,7 @@ func NewGetUserBidsHandler(logger slog.Logger, getUserBidsUseCase usecases.GetUs
 			return
 		}
 
-		api.WriteJSON(w, http.StatusOK, bids)
+		api.SetPaginationHeaders(w, r, page.NextToken(), page.Total)
+		api.WriteJSON(w, http.StatusOK, page.Items)
 	}
 }
@@ -6,12 +6,13 @@ import (
 	"net/http"
 	"strconv"
 	"tms/src/core/domain"
+	"tms/src/core/services"
 	usecases "tms/src/core/services/use-cases/bid"
 	"tms/src/pkg/api"
 	"tms/src/pkg/logger/sl"
 )
 
-func NewRollBackHandler(logger slog.Logger, uc usecases.RollbackBidUseCase) http.HandlerFunc {
+func NewRollBackHandler(logger slog.Logger, uc services.UseCase[usecases.RollbackBidDTO, *usecases.RollbackBidResult]) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		bidID := r.PathValue("bidId")
 		if bidID == "" {
@@ -41,13 +42,21 @@ func NewRollBackHandler(logger slog.Logger, uc usecases.RollbackBidUseCase) http
 			return
 		}
 
+		dryRun, err := api.ParseBoolQueryParam(r, "dryRun")
+		if err != nil {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+			logger.Error("invalid dryRun", sl.Err(err))
+			return
+		}
+
 		dto := usecases.RollbackBidDTO{
 			BidID:    bidID,
 			Version:  version,
 			Username: username,
+			DryRun:   dryRun != nil && *dryRun,
 		}
 		log := logger.With("dto", dto)
-		bid, err := uc.Execute(dto)
+		result, err := uc.Execute(r.Context(), dto)
 		if err != nil {
 			if errors.Is(errors.Cause(err), domain.ErrValidation) {
 				api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
@@ -79,6 +88,11 @@ func NewRollBackHandler(logger slog.Logger, uc usecases.RollbackBidUseCase) http
 			return
 		}
 
-		api.WriteJSON(w, http.StatusOK, bid)
+		if dto.DryRun {
+			api.WriteJSON(w, http.StatusOK, result)
+			return
+		}
+
+		api.WriteJSON(w, http.StatusOK, result.Bid)
 	}
 }
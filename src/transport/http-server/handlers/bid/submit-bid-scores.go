@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"github.com/pkg/errors"
+	"log/slog"
+	"net/http"
+	"tms/src/core/domain"
+	"tms/src/core/services"
+	usecases "tms/src/core/services/use-cases/bid"
+	"tms/src/pkg/api"
+	"tms/src/pkg/logger/sl"
+)
+
+type SubmitBidScoresHandlerBody struct {
+	Scores map[string]int `json:"scores"`
+}
+
+func NewSubmitBidScoresHandler(logger slog.Logger, uc services.UseCase[usecases.SubmitBidScoresDTO, []domain.BidScore]) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		op := "SubmitBidScoresHandler"
+
+		log := logger.With("op", op)
+
+		bidID := r.PathValue("bidId")
+
+		if bidID == "" {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("bidId is required"))
+			log.Error("bidId is required")
+			return
+		}
+
+		username := r.URL.Query().Get("username")
+
+		if username == "" {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("username is required"))
+			log.Error("username is required")
+			return
+		}
+
+		body, err := api.ReadJSON[SubmitBidScoresHandlerBody](r)
+
+		if err != nil {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("cannot parse body"))
+			log.Error("cannot parse body", sl.Err(err))
+			return
+		}
+
+		dto := usecases.SubmitBidScoresDTO{
+			BidID:    bidID,
+			Username: username,
+			Scores:   body.Scores,
+		}
+
+		log = log.With("dto", dto)
+
+		scores, err := uc.Execute(r.Context(), dto)
+
+		if err != nil {
+			if errors.Is(errors.Cause(err), domain.ErrValidation) {
+				api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+				log.Error("validation failed", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrNotFound) {
+				api.WriteJSON(w, http.StatusNotFound, api.Error(err.Error()))
+				log.Error("some entity not found", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrNoPermission) {
+				api.WriteJSON(w, http.StatusForbidden, api.Error(err.Error()))
+				log.Error("permission denied", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrUserNotFound) {
+				api.WriteJSON(w, http.StatusUnauthorized, api.Error(err.Error()))
+				log.Error("user not found", sl.Err(err))
+				return
+			}
+			api.WriteJSON(w, http.StatusInternalServerError, api.Error("internal server error"))
+			log.Error("cannot execute submitBidScoresUseCase", sl.Err(err))
+			return
+		}
+
+		log.Info("bid scores submitted")
+		api.WriteJSON(w, http.StatusOK, scores)
+	}
+}
@@ -5,12 +5,13 @@ import (
 	"log/slog"
 	"net/http"
 	"tms/src/core/domain"
+	"tms/src/core/services"
 	usecases "tms/src/core/services/use-cases/bid"
 	"tms/src/pkg/api"
 	"tms/src/pkg/logger/sl"
 )
 
-func NewSubmitDecisionHandler(logger slog.Logger, uc usecases.SubmitDecisionUseCase) http.HandlerFunc {
+func NewSubmitDecisionHandler(logger slog.Logger, uc services.UseCase[usecases.SubmitDecisionDTO, *domain.Bid]) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		bidID := r.PathValue("bidId")
 		if bidID == "" {
@@ -37,9 +38,10 @@ func NewSubmitDecisionHandler(logger slog.Logger, uc usecases.SubmitDecisionUseC
 			BidID:    bidID,
 			Decision: decision,
 			Username: username,
+			Reason:   api.ParseStringQueryParam(r, "reason"),
 		}
 		log := logger.With("dto", dto)
-		bid, err := uc.Execute(dto)
+		bid, err := uc.Execute(r.Context(), dto)
 		if err != nil {
 			if errors.Is(errors.Cause(err), domain.ErrValidation) {
 				api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"github.com/pkg/errors"
+	"log/slog"
+	"net/http"
+	"tms/src/core/domain"
+	"tms/src/core/services"
+	usecases "tms/src/core/services/use-cases/exchange-rate"
+	"tms/src/pkg/api"
+	"tms/src/pkg/logger/sl"
+)
+
+type CreateExchangeRateHandlerBody struct {
+	From          string `json:"from"`
+	To            string `json:"to"`
+	Rate          string `json:"rate"`
+	EffectiveDate string `json:"effectiveDate"`
+}
+
+func NewCreateExchangeRateHandler(logger slog.Logger, uc services.UseCase[usecases.CreateExchangeRateDTO, *domain.ExchangeRate]) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		op := "CreateExchangeRateHandler"
+
+		log := logger.With("op", op)
+
+		username := r.URL.Query().Get("username")
+
+		if username == "" {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("username is required"))
+			log.Error("username is required")
+			return
+		}
+
+		body, err := api.ReadJSON[CreateExchangeRateHandlerBody](r)
+
+		if err != nil {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("cannot parse body"))
+			log.Error("cannot parse body", sl.Err(err))
+			return
+		}
+
+		dto := usecases.CreateExchangeRateDTO{
+			Username:      username,
+			From:          body.From,
+			To:            body.To,
+			Rate:          body.Rate,
+			EffectiveDate: body.EffectiveDate,
+		}
+
+		log = log.With("username", username, "from", body.From, "to", body.To, "effectiveDate", body.EffectiveDate)
+
+		rate, err := uc.Execute(r.Context(), dto)
+
+		if err != nil {
+			if errors.Is(errors.Cause(err), domain.ErrValidation) {
+				api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+				log.Error("validation failed", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrAlreadyExist) {
+				api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+				log.Error("already exists", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrNoPermission) {
+				api.WriteJSON(w, http.StatusForbidden, api.Error(err.Error()))
+				log.Error("permission denied", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrUserNotFound) {
+				api.WriteJSON(w, http.StatusUnauthorized, api.Error(err.Error()))
+				log.Error("user not found", sl.Err(err))
+				return
+			}
+			api.WriteJSON(w, http.StatusInternalServerError, api.Error("internal server error"))
+			log.Error("cannot execute createExchangeRateUseCase", sl.Err(err))
+			return
+		}
+
+		log.Info("exchange rate created", slog.String("id", string(rate.ID)))
+		api.WriteJSON(w, http.StatusOK, rate)
+	}
+}
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"github.com/pkg/errors"
+	"log/slog"
+	"net/http"
+	"tms/src/core/domain"
+	"tms/src/core/services"
+	usecases "tms/src/core/services/use-cases/exchange-rate"
+	"tms/src/pkg/api"
+	"tms/src/pkg/logger/sl"
+)
+
+func NewDeleteExchangeRateHandler(logger slog.Logger, uc services.UseCase[usecases.DeleteExchangeRateDTO, *domain.ExchangeRate]) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		op := "DeleteExchangeRateHandler"
+
+		log := logger.With("op", op)
+
+		exchangeRateID := r.PathValue("exchangeRateId")
+
+		if exchangeRateID == "" {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("exchangeRateId is required"))
+			log.Error("exchangeRateId is required")
+			return
+		}
+
+		username := r.URL.Query().Get("username")
+
+		if username == "" {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("username is required"))
+			log.Error("username is required")
+			return
+		}
+
+		log = log.With("exchangeRateId", exchangeRateID, "username", username)
+
+		rate, err := uc.Execute(r.Context(), usecases.DeleteExchangeRateDTO{
+			ExchangeRateID: exchangeRateID,
+			Username:       username,
+		})
+
+		if err != nil {
+			if errors.Is(errors.Cause(err), domain.ErrNotFound) {
+				api.WriteJSON(w, http.StatusNotFound, api.Error(err.Error()))
+				log.Error("some entity not found", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrNoPermission) {
+				api.WriteJSON(w, http.StatusForbidden, api.Error(err.Error()))
+				log.Error("permission denied", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrUserNotFound) {
+				api.WriteJSON(w, http.StatusUnauthorized, api.Error(err.Error()))
+				log.Error("user not found", sl.Err(err))
+				return
+			}
+			api.WriteJSON(w, http.StatusInternalServerError, api.Error("internal server error"))
+			log.Error("cannot execute deleteExchangeRateUseCase", sl.Err(err))
+			return
+		}
+
+		log.Info("exchange rate deleted")
+		api.WriteJSON(w, http.StatusOK, rate)
+	}
+}
@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"github.com/pkg/errors"
+	"log/slog"
+	"net/http"
+	"tms/src/core/domain"
+	usecases "tms/src/core/services/use-cases/exchange-rate"
+	"tms/src/pkg/api"
+	"tms/src/pkg/logger/sl"
+)
+
+func NewGetExchangeRatesHandler(logger slog.Logger, uc usecases.GetExchangeRatesUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		op := "GetExchangeRatesHandler"
+
+		log := logger.With("op", op)
+
+		username := r.URL.Query().Get("username")
+
+		if username == "" {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("username is required"))
+			log.Error("username is required")
+			return
+		}
+
+		organizationID := r.URL.Query().Get("organizationId")
+
+		if organizationID == "" {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("organizationId is required"))
+			log.Error("organizationId is required")
+			return
+		}
+
+		limit, err := api.ParseIntQueryParam(r, "limit")
+		if err != nil {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+			log.Error("invalid limit", sl.Err(err))
+			return
+		}
+
+		offset, err := api.ParseIntQueryParam(r, "offset")
+		if err != nil {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+			log.Error("invalid offset", sl.Err(err))
+			return
+		}
+
+		dto := usecases.GetExchangeRatesDTO{
+			Username:       username,
+			OrganizationID: organizationID,
+			From:           api.ParseStringQueryParam(r, "from"),
+			To:             api.ParseStringQueryParam(r, "to"),
+			Date:           api.ParseStringQueryParam(r, "date"),
+			Limit:          limit,
+			Offset:         offset,
+		}
+
+		log = log.With("dto", dto)
+
+		rates, err := uc.Execute(dto)
+
+		if err != nil {
+			if errors.Is(errors.Cause(err), domain.ErrValidation) {
+				api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+				log.Error("validation failed", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrUserNotFound) {
+				api.WriteJSON(w, http.StatusUnauthorized, api.Error(err.Error()))
+				log.Error("user not found", sl.Err(err))
+				return
+			}
+			api.WriteJSON(w, http.StatusInternalServerError, api.Error("internal server error"))
+			log.Error("cannot execute getExchangeRatesUseCase", sl.Err(err))
+			return
+		}
+
+		api.WriteJSON(w, http.StatusOK, rates)
+	}
+}
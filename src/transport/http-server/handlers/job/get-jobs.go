@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"github.com/pkg/errors"
+	"log/slog"
+	"net/http"
+	"tms/src/core/domain"
+	usecases "tms/src/core/services/use-cases/job"
+	"tms/src/pkg/api"
+	"tms/src/pkg/logger/sl"
+)
+
+func NewGetJobsHandler(logger slog.Logger, uc usecases.GetJobsUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		op := "GetJobsHandler"
+
+		log := logger.With("op", op)
+
+		username := r.URL.Query().Get("username")
+
+		if username == "" {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("username is required"))
+			log.Error("username is required")
+			return
+		}
+
+		limit, err := api.ParseIntQueryParam(r, "limit")
+		if err != nil {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+			log.Error("invalid limit", sl.Err(err))
+			return
+		}
+
+		offset, err := api.ParseIntQueryParam(r, "offset")
+		if err != nil {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+			log.Error("invalid offset", sl.Err(err))
+			return
+		}
+
+		dto := usecases.GetJobsDTO{
+			Username: username,
+			Status:   api.ParseStringQueryParam(r, "status"),
+			Limit:    limit,
+			Offset:   offset,
+		}
+
+		log = log.With("dto", dto)
+
+		jobs, err := uc.Execute(dto)
+
+		if err != nil {
+			if errors.Is(errors.Cause(err), domain.ErrValidation) {
+				api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+				log.Error("validation failed", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrNoPermission) {
+				api.WriteJSON(w, http.StatusForbidden, api.Error(err.Error()))
+				log.Error("permission denied", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrUserNotFound) {
+				api.WriteJSON(w, http.StatusUnauthorized, api.Error(err.Error()))
+				log.Error("user not found", sl.Err(err))
+				return
+			}
+			api.WriteJSON(w, http.StatusInternalServerError, api.Error("internal server error"))
+			log.Error("cannot execute getJobsUseCase", sl.Err(err))
+			return
+		}
+
+		api.WriteJSON(w, http.StatusOK, jobs)
+	}
+}
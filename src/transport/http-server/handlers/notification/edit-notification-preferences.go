@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"github.com/pkg/errors"
+	"log/slog"
+	"net/http"
+	"tms/src/core/domain"
+	"tms/src/core/services"
+	usecases "tms/src/core/services/use-cases/notification"
+	"tms/src/pkg/api"
+	"tms/src/pkg/logger/sl"
+)
+
+type EditNotificationPreferencesHandlerBody struct {
+	EmailEnabled *bool   `json:"emailEnabled"`
+	Language     *string `json:"language"`
+}
+
+func NewEditNotificationPreferencesHandler(logger slog.Logger, uc services.UseCase[usecases.EditNotificationPreferencesDTO, *domain.NotificationPreferences]) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		op := "EditNotificationPreferencesHandler"
+
+		log := logger.With("op", op)
+
+		username := r.URL.Query().Get("username")
+
+		if username == "" {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("username is required"))
+			log.Error("username is required")
+			return
+		}
+
+		body, err := api.ReadJSON[EditNotificationPreferencesHandlerBody](r)
+
+		if err != nil {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("cannot parse body"))
+			log.Error("cannot parse body", sl.Err(err))
+			return
+		}
+
+		dto := usecases.EditNotificationPreferencesDTO{
+			Username:     username,
+			EmailEnabled: body.EmailEnabled,
+			Language:     body.Language,
+		}
+
+		log = log.With("dto", dto)
+
+		preferences, err := uc.Execute(r.Context(), dto)
+
+		if err != nil {
+			if errors.Is(errors.Cause(err), domain.ErrValidation) {
+				api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+				log.Error("validation failed", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrUserNotFound) {
+				api.WriteJSON(w, http.StatusUnauthorized, api.Error(err.Error()))
+				log.Error("user not found", sl.Err(err))
+				return
+			}
+			api.WriteJSON(w, http.StatusInternalServerError, api.Error("internal server error"))
+			log.Error("cannot execute editNotificationPreferencesUseCase", sl.Err(err))
+			return
+		}
+
+		log.Info("notification preferences updated")
+		api.WriteJSON(w, http.StatusOK, preferences)
+	}
+}
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"github.com/pkg/errors"
+	"log/slog"
+	"net/http"
+	"tms/src/core/domain"
+	usecases "tms/src/core/services/use-cases/notification"
+	"tms/src/pkg/api"
+	"tms/src/pkg/logger/sl"
+)
+
+func NewGetNotificationPreferencesHandler(logger slog.Logger, uc usecases.GetNotificationPreferencesUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		op := "GetNotificationPreferencesHandler"
+
+		log := logger.With("op", op)
+
+		username := r.URL.Query().Get("username")
+
+		if username == "" {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("username is required"))
+			log.Error("username is required")
+			return
+		}
+
+		dto := usecases.GetNotificationPreferencesDTO{
+			Username: username,
+		}
+
+		log = log.With("dto", dto)
+
+		preferences, err := uc.Execute(dto)
+
+		if err != nil {
+			if errors.Is(errors.Cause(err), domain.ErrUserNotFound) {
+				api.WriteJSON(w, http.StatusUnauthorized, api.Error(err.Error()))
+				log.Error("user not found", sl.Err(err))
+				return
+			}
+			api.WriteJSON(w, http.StatusInternalServerError, api.Error("internal server error"))
+			log.Error("cannot execute getNotificationPreferencesUseCase", sl.Err(err))
+			return
+		}
+
+		api.WriteJSON(w, http.StatusOK, preferences)
+	}
+}
@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"github.com/pkg/errors"
+	"log/slog"
+	"net/http"
+	"tms/src/core/domain"
+	"tms/src/core/services"
+	usecases "tms/src/core/services/use-cases/notification"
+	"tms/src/pkg/api"
+	"tms/src/pkg/logger/sl"
+)
+
+func NewReadAllNotificationsHandler(logger slog.Logger, uc services.UseCase[usecases.ReadAllNotificationsDTO, *usecases.NotificationsCount]) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		op := "ReadAllNotificationsHandler"
+
+		log := logger.With("op", op)
+
+		username := r.URL.Query().Get("username")
+
+		if username == "" {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("username is required"))
+			log.Error("username is required")
+			return
+		}
+
+		dto := usecases.ReadAllNotificationsDTO{
+			Username: username,
+		}
+
+		log = log.With("dto", dto)
+
+		count, err := uc.Execute(r.Context(), dto)
+
+		if err != nil {
+			if errors.Is(errors.Cause(err), domain.ErrUserNotFound) {
+				api.WriteJSON(w, http.StatusUnauthorized, api.Error(err.Error()))
+				log.Error("user not found", sl.Err(err))
+				return
+			}
+			api.WriteJSON(w, http.StatusInternalServerError, api.Error("internal server error"))
+			log.Error("cannot execute readAllNotificationsUseCase", sl.Err(err))
+			return
+		}
+
+		log.Info("notifications marked as read", slog.Int("count", count.Count))
+		api.WriteJSON(w, http.StatusOK, count)
+	}
+}
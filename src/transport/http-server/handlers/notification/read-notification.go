@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"github.com/pkg/errors"
+	"log/slog"
+	"net/http"
+	"tms/src/core/domain"
+	"tms/src/core/services"
+	usecases "tms/src/core/services/use-cases/notification"
+	"tms/src/pkg/api"
+	"tms/src/pkg/logger/sl"
+)
+
+func NewReadNotificationHandler(logger slog.Logger, uc services.UseCase[usecases.ReadNotificationDTO, *domain.Notification]) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		op := "ReadNotificationHandler"
+
+		log := logger.With("op", op)
+
+		notificationID := r.PathValue("notificationId")
+
+		if notificationID == "" {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("notificationId is required"))
+			log.Error("notificationId is required")
+			return
+		}
+
+		username := r.URL.Query().Get("username")
+
+		if username == "" {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("username is required"))
+			log.Error("username is required")
+			return
+		}
+
+		dto := usecases.ReadNotificationDTO{
+			NotificationID: notificationID,
+			Username:       username,
+		}
+
+		log = log.With("dto", dto)
+
+		notification, err := uc.Execute(r.Context(), dto)
+
+		if err != nil {
+			if errors.Is(errors.Cause(err), domain.ErrValidation) {
+				api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+				log.Error("validation failed", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrNotFound) {
+				api.WriteJSON(w, http.StatusNotFound, api.Error(err.Error()))
+				log.Error("some entity not found", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrNoPermission) {
+				api.WriteJSON(w, http.StatusForbidden, api.Error(err.Error()))
+				log.Error("permission denied", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrUserNotFound) {
+				api.WriteJSON(w, http.StatusUnauthorized, api.Error(err.Error()))
+				log.Error("user not found", sl.Err(err))
+				return
+			}
+			api.WriteJSON(w, http.StatusInternalServerError, api.Error("internal server error"))
+			log.Error("cannot execute readNotificationUseCase", sl.Err(err))
+			return
+		}
+
+		api.WriteJSON(w, http.StatusOK, notification)
+	}
+}
@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-chi/chi/v5/middleware"
+	"log/slog"
+	"net/http"
+	"tms/src/pkg/api"
+	"tms/src/pkg/logger/sl"
+	"tms/src/transport/http-server/openapi"
+)
+
+// NewOpenAPIHandler отдает спецификацию в формате, заданном расширением пути (.json или .yaml)
+func NewOpenAPIHandler(log slog.Logger, doc *openapi3.T) http.HandlerFunc {
+	specJSON, err := doc.MarshalJSON()
+	if err != nil {
+		log.Error("failed to marshal openapi spec", sl.Err(err))
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		format, _ := r.Context().Value(middleware.URLFormatCtxKey).(string)
+
+		switch format {
+		case "json":
+			if specJSON == nil {
+				api.WriteJSON(w, http.StatusInternalServerError, api.Error("internal server error"))
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write(specJSON)
+
+		case "yaml", "yml":
+			w.Header().Set("Content-Type", "application/yaml")
+			w.WriteHeader(http.StatusOK)
+			w.Write(openapi.Spec())
+
+		default:
+			api.WriteJSON(w, http.StatusNotFound, api.Error("unknown specification format"))
+		}
+	}
+}
+
+// NewDocsHandler отдает HTML страницу интерактивной документации
+func NewDocsHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		w.Write(openapi.Docs())
+	}
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"github.com/pkg/errors"
+	"log/slog"
+	"net/http"
+	"tms/src/core/domain"
+	"tms/src/core/services"
+	usecases "tms/src/core/services/use-cases/saved-search"
+	"tms/src/pkg/api"
+	"tms/src/pkg/logger/sl"
+)
+
+func NewDeleteSavedSearchHandler(logger slog.Logger, uc services.UseCase[usecases.DeleteSavedSearchDTO, *domain.SavedSearch]) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		op := "DeleteSavedSearchHandler"
+
+		log := logger.With("op", op)
+
+		savedSearchID := r.PathValue("savedSearchId")
+
+		if savedSearchID == "" {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("savedSearchId is required"))
+			log.Error("savedSearchId is required")
+			return
+		}
+
+		username := r.URL.Query().Get("username")
+
+		if username == "" {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("username is required"))
+			log.Error("username is required")
+			return
+		}
+
+		log = log.With("savedSearchId", savedSearchID, "username", username)
+
+		search, err := uc.Execute(r.Context(), usecases.DeleteSavedSearchDTO{
+			SavedSearchID: savedSearchID,
+			Username:      username,
+		})
+
+		if err != nil {
+			if errors.Is(errors.Cause(err), domain.ErrValidation) {
+				api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+				log.Error("validation failed", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrNotFound) {
+				api.WriteJSON(w, http.StatusNotFound, api.Error(err.Error()))
+				log.Error("some entity not found", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrNoPermission) {
+				api.WriteJSON(w, http.StatusForbidden, api.Error(err.Error()))
+				log.Error("permission denied", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrUserNotFound) {
+				api.WriteJSON(w, http.StatusUnauthorized, api.Error(err.Error()))
+				log.Error("user not found", sl.Err(err))
+				return
+			}
+			api.WriteJSON(w, http.StatusInternalServerError, api.Error("internal server error"))
+			log.Error("cannot execute deleteSavedSearchUseCase", sl.Err(err))
+			return
+		}
+
+		log.Info("saved search deleted")
+		api.WriteJSON(w, http.StatusOK, search)
+	}
+}
@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+	"log/slog"
+	"net/http"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services/stream"
+	usecases "tms/src/core/services/use-cases/stream"
+	"tms/src/pkg/api"
+	"tms/src/pkg/logger/sl"
+)
+
+// heartbeatInterval период комментариев, которые не дают прокси закрыть простаивающее соединение
+const heartbeatInterval = 15 * time.Second
+
+func NewStreamEventsHandler(logger slog.Logger, uc usecases.SubscribeUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		op := "StreamEventsHandler"
+
+		log := logger.With("op", op)
+
+		username := r.URL.Query().Get("username")
+
+		if username == "" {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("username is required"))
+			log.Error("username is required")
+			return
+		}
+
+		dto := usecases.SubscribeDTO{
+			Username: username,
+		}
+		if lastEventID := r.Header.Get("Last-Event-ID"); lastEventID != "" {
+			dto.LastEventID = &lastEventID
+		}
+
+		log = log.With("dto", dto)
+
+		subscription, err := uc.Execute(r.Context(), dto)
+
+		if err != nil {
+			if errors.Is(errors.Cause(err), domain.ErrValidation) {
+				api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+				log.Error("validation failed", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrUserNotFound) {
+				api.WriteJSON(w, http.StatusUnauthorized, api.Error(err.Error()))
+				log.Error("user not found", sl.Err(err))
+				return
+			}
+			api.WriteJSON(w, http.StatusInternalServerError, api.Error("internal server error"))
+			log.Error("cannot execute subscribeUseCase", sl.Err(err))
+			return
+		}
+		defer subscription.Close()
+
+		// Поток открыт дольше WriteTimeout сервера
+		rc := http.NewResponseController(w)
+		_ = rc.SetWriteDeadline(time.Time{})
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.Header().Set("X-Accel-Buffering", "no")
+		w.WriteHeader(http.StatusOK)
+
+		for _, m := range subscription.Backlog {
+			writeEvent(w, m)
+		}
+		if err := rc.Flush(); err != nil {
+			log.Error("streaming is not supported", sl.Err(err))
+			return
+		}
+
+		log.Info("event stream opened", slog.Int("backlog", len(subscription.Backlog)))
+
+		heartbeat := time.NewTicker(heartbeatInterval)
+		defer heartbeat.Stop()
+
+		for {
+			select {
+			case <-r.Context().Done():
+				log.Info("event stream closed")
+				return
+			case m, ok := <-subscription.C:
+				if !ok {
+					log.Info("event stream subscription dropped")
+					return
+				}
+				if subscription.Replayed(m.Seq) {
+					continue
+				}
+				writeEvent(w, m)
+			case <-heartbeat.C:
+				_, _ = fmt.Fprint(w, ": ping\n\n")
+			}
+
+			if err := rc.Flush(); err != nil {
+				log.Info("event stream closed", sl.Err(err))
+				return
+			}
+		}
+	}
+}
+
+func writeEvent(w http.ResponseWriter, m stream.Message) {
+	_, _ = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", m.Seq, m.Event, m.Data)
+}
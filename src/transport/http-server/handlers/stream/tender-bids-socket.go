@@ -0,0 +1,158 @@
+package handlers
+
+import (
+	"encoding/json"
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	"log/slog"
+	"net/http"
+	"time"
+	"tms/src/core/domain"
+	usecases "tms/src/core/services/use-cases/stream"
+	"tms/src/pkg/api"
+	"tms/src/pkg/logger/sl"
+)
+
+const (
+	socketWriteWait  = 10 * time.Second
+	socketPongWait   = 60 * time.Second
+	socketPingPeriod = socketPongWait * 9 / 10
+	// socketReadLimit клиент ничего не отправляет, кроме управляющих кадров
+	socketReadLimit = 512
+)
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+}
+
+// socketMessage Сообщение WebSocket о событии тендера
+type socketMessage struct {
+	ID    int64            `json:"id"`
+	Event domain.EventName `json:"event"`
+	Data  json.RawMessage  `json:"data"`
+}
+
+func NewTenderBidsSocketHandler(logger slog.Logger, uc usecases.SubscribeTenderUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		op := "TenderBidsSocketHandler"
+
+		log := logger.With("op", op)
+
+		tenderID := r.PathValue("tenderId")
+
+		if tenderID == "" {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("tenderId is required"))
+			log.Error("tenderId is required")
+			return
+		}
+
+		username := r.URL.Query().Get("username")
+
+		if username == "" {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("username is required"))
+			log.Error("username is required")
+			return
+		}
+
+		dto := usecases.SubscribeTenderDTO{
+			TenderID: tenderID,
+			Username: username,
+		}
+
+		log = log.With("dto", dto)
+
+		// Права проверяются до установки соединения, чтобы отказ вернулся обычным HTTP-ответом
+		subscription, err := uc.Execute(r.Context(), dto)
+
+		if err != nil {
+			if errors.Is(errors.Cause(err), domain.ErrValidation) {
+				api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+				log.Error("validation failed", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrNotFound) {
+				api.WriteJSON(w, http.StatusNotFound, api.Error(err.Error()))
+				log.Error("some entity not found", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrNoPermission) {
+				api.WriteJSON(w, http.StatusForbidden, api.Error(err.Error()))
+				log.Error("permission denied", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrUserNotFound) {
+				api.WriteJSON(w, http.StatusUnauthorized, api.Error(err.Error()))
+				log.Error("user not found", sl.Err(err))
+				return
+			}
+			api.WriteJSON(w, http.StatusInternalServerError, api.Error("internal server error"))
+			log.Error("cannot execute subscribeTenderUseCase", sl.Err(err))
+			return
+		}
+		defer subscription.Close()
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			// Upgrade сам отвечает клиенту
+			log.Error("cannot upgrade connection", sl.Err(err))
+			return
+		}
+		defer conn.Close()
+
+		log.Info("tender socket opened")
+
+		closed := readControl(conn)
+
+		ping := time.NewTicker(socketPingPeriod)
+		defer ping.Stop()
+
+		for {
+			select {
+			case <-closed:
+				log.Info("tender socket closed")
+				return
+			case m, ok := <-subscription.C:
+				_ = conn.SetWriteDeadline(time.Now().Add(socketWriteWait))
+				if !ok {
+					_ = conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseGoingAway, ""))
+					log.Info("tender socket subscription dropped")
+					return
+				}
+				if err := conn.WriteJSON(socketMessage{ID: m.Seq, Event: m.Event, Data: m.Data}); err != nil {
+					log.Info("tender socket closed", sl.Err(err))
+					return
+				}
+			case <-ping.C:
+				_ = conn.SetWriteDeadline(time.Now().Add(socketWriteWait))
+				if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+					log.Info("tender socket closed", sl.Err(err))
+					return
+				}
+			}
+		}
+	}
+}
+
+// readControl читает входящие кадры, чтобы обрабатывать pong и закрытие соединения.
+// Возвращаемый канал закрывается, когда клиент отключился или перестал отвечать на ping
+func readControl(conn *websocket.Conn) <-chan struct{} {
+	closed := make(chan struct{})
+
+	conn.SetReadLimit(socketReadLimit)
+	_ = conn.SetReadDeadline(time.Now().Add(socketPongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(socketPongWait))
+	})
+
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	return closed
+}
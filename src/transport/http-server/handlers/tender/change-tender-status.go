@@ -6,12 +6,13 @@ import (
 	"log/slog"
 	"net/http"
 	"tms/src/core/domain"
+	"tms/src/core/services"
 	usecases "tms/src/core/services/use-cases/tender"
 	"tms/src/pkg/api"
 	"tms/src/pkg/logger/sl"
 )
 
-func NewChangeTenderStatusHandler(logger slog.Logger, changeTenderStatusUseCase usecases.ChangeTenderStatusUseCase) http.HandlerFunc {
+func NewChangeTenderStatusHandler(logger slog.Logger, changeTenderStatusUseCase services.UseCase[usecases.ChangeTenderStatusDTO, *domain.Tender]) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		op := "ChangeTenderStatusHandler"
 
@@ -45,11 +46,12 @@ func NewChangeTenderStatusHandler(logger slog.Logger, changeTenderStatusUseCase
 			TenderID: tenderID,
 			Status:   status,
 			Username: username,
+			Reason:   api.ParseStringQueryParam(r, "reason"),
 		}
 
 		log = log.With("dto", dto)
 
-		tender, err := changeTenderStatusUseCase.Execute(dto)
+		tender, err := changeTenderStatusUseCase.Execute(r.Context(), dto)
 
 		if err != nil {
 			if errors.Is(errors.Cause(err), domain.ErrValidation) {
@@ -6,12 +6,13 @@ import (
 	"log/slog"
 	"net/http"
 	"tms/src/core/domain"
+	"tms/src/core/services"
 	usecases "tms/src/core/services/use-cases/tender"
 	"tms/src/pkg/api"
 	"tms/src/pkg/logger/sl"
 )
 
-func NewCreateTenderHandler(log slog.Logger, createTenderUseCase usecases.CreateTenderUseCase) http.HandlerFunc {
+func NewCreateTenderHandler(log slog.Logger, createTenderUseCase services.UseCase[usecases.CreateTenderDTO, *domain.Tender]) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		op := "CreateTenderHandler"
 
@@ -21,13 +22,13 @@ func NewCreateTenderHandler(log slog.Logger, createTenderUseCase usecases.Create
 
 		if err != nil {
 			api.WriteJSON(w, http.StatusBadRequest, api.Error(fmt.Sprintf("unable to parse request: %s", err.Error())))
-			l.Error("unable to parse request", err.Error())
+			l.Error("unable to parse request", sl.Err(err))
 			return
 		}
 
 		l = l.With("body", body)
 
-		tender, err := createTenderUseCase.Execute(*body)
+		tender, err := createTenderUseCase.Execute(r.Context(), *body)
 
 		if err != nil {
 			if errors.Is(errors.Cause(err), domain.ErrValidation) {
@@ -56,11 +57,11 @@ func NewCreateTenderHandler(log slog.Logger, createTenderUseCase usecases.Create
 				return
 			}
 			api.WriteJSON(w, http.StatusBadRequest, api.Error(fmt.Sprintf("unable to create Tender: %s", err.Error())))
-			l.Error("unable to create Tender", err.Error())
+			l.Error("unable to create Tender", sl.Err(err))
 			return
 		}
 
-		l.Info("tender created", tender)
+		l.Info("tender created", slog.String("id", string(tender.ID)))
 		api.WriteJSON(w, http.StatusOK, tender)
 	}
 }
@@ -4,19 +4,24 @@ import (
 	"github.com/pkg/errors"
 	"log/slog"
 	"net/http"
+	"time"
 	"tms/src/core/domain"
+	"tms/src/core/services"
 	usecases "tms/src/core/services/use-cases/tender"
 	"tms/src/pkg/api"
 	"tms/src/pkg/logger/sl"
 )
 
 type EditTenderHandlerBody struct {
-	Name        *string `json:"name"`
-	Description *string `json:"description"`
-	ServiceType *string `json:"serviceType"`
+	Name               *string       `json:"name"`
+	Description        *string       `json:"description"`
+	ServiceType        *string       `json:"serviceType"`
+	Budget             *domain.Money `json:"budget"`
+	SubmissionDeadline *time.Time    `json:"submissionDeadline"`
+	EvaluationDeadline *time.Time    `json:"evaluationDeadline"`
 }
 
-func NewEditTenderHandler(logger slog.Logger, editTenderUseCase usecases.EditTenderUseCase) http.HandlerFunc {
+func NewEditTenderHandler(logger slog.Logger, editTenderUseCase services.UseCase[usecases.EditTenderUseCaseDTO, *domain.Tender]) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		op := "EditTenderHandler"
 
@@ -47,16 +52,19 @@ func NewEditTenderHandler(logger slog.Logger, editTenderUseCase usecases.EditTen
 		}
 
 		dto := usecases.EditTenderUseCaseDTO{
-			TenderID:    tenderID,
-			Username:    username,
-			Name:        body.Name,
-			Description: body.Description,
-			ServiceType: body.ServiceType,
+			TenderID:           tenderID,
+			Username:           username,
+			Name:               body.Name,
+			Description:        body.Description,
+			ServiceType:        body.ServiceType,
+			Budget:             body.Budget,
+			SubmissionDeadline: body.SubmissionDeadline,
+			EvaluationDeadline: body.EvaluationDeadline,
 		}
 
 		log = log.With("dto", dto)
 
-		tender, err := editTenderUseCase.Execute(dto)
+		tender, err := editTenderUseCase.Execute(r.Context(), dto)
 
 		if err != nil {
 			if errors.Is(errors.Cause(err), domain.ErrValidation) {
@@ -15,19 +15,48 @@ func NewGetAllTendersHandler(log slog.Logger, getAllTendersUseCase usecases.GetA
 		op := "GetAllTendersHandler"
 		l := log.With("op", op)
 
-		limit, _ := api.ParseIntQueryParam(r, "limit")
-		offset, _ := api.ParseIntQueryParam(r, "offset")
-		serviceType := api.ParseStringQueryParam(r, "service_type")
+		limit, err := api.ParseIntQueryParam(r, "limit")
+		if err != nil {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+			l.Error("invalid limit", sl.Err(err))
+			return
+		}
+
+		offset, err := api.ParseIntQueryParam(r, "offset")
+		if err != nil {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+			l.Error("invalid offset", sl.Err(err))
+			return
+		}
+
+		includeTotal, err := api.ParseBoolQueryParam(r, "include_total")
+		if err != nil {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+			l.Error("invalid include_total", sl.Err(err))
+			return
+		}
 
 		dto := usecases.GetAllTendersDTO{
-			Limit:       limit,
-			Offset:      offset,
-			ServiceType: serviceType,
+			Limit:           limit,
+			Offset:          offset,
+			ServiceTypes:    api.ParseStringsQueryParam(r, "service_type"),
+			OrganizationIDs: api.ParseStringsQueryParam(r, "organization_id"),
+			Query:           api.ParseStringQueryParam(r, "q"),
+			CreatedFrom:     api.ParseStringQueryParam(r, "created_from"),
+			CreatedTo:       api.ParseStringQueryParam(r, "created_to"),
+			UpdatedSince:    api.ParseStringQueryParam(r, "updated_since"),
+			BudgetCurrency:  api.ParseStringQueryParam(r, "budget_currency"),
+			MinBudget:       api.ParseStringQueryParam(r, "budget_min"),
+			MaxBudget:       api.ParseStringQueryParam(r, "budget_max"),
+			SortBy:          api.ParseStringQueryParam(r, "sort_by"),
+			SortOrder:       api.ParseStringQueryParam(r, "sort_order"),
+			Cursor:          api.ParseStringQueryParam(r, "cursor"),
+			IncludeTotal:    includeTotal != nil && *includeTotal,
 		}
 
 		l = l.With("dto", dto)
 
-		tenders, err := getAllTendersUseCase.Execute(dto)
+		page, err := getAllTendersUseCase.Execute(dto)
 
 		if err != nil {
 			if errors.Is(errors.Cause(err), domain.ErrValidation) {
@@ -55,14 +84,15 @@ func NewGetAllTendersHandler(log slog.Logger, getAllTendersUseCase usecases.GetA
 				log.Error("user not found", sl.Err(err))
 				return
 			}
-			l.Error("error while executing GetAllTendersUseCase", "error", err)
+			l.Error("error while executing GetAllTendersUseCase", sl.Err(err))
 			resp := api.Error("Внутренняя ошибка сервера")
 			api.WriteJSON(w, http.StatusInternalServerError, resp)
 			return
 		}
 
-		l.Info("GetAllTendersUseCase executed successfully", tenders)
+		l.Info("GetAllTendersUseCase executed successfully", slog.Int("count", len(page.Items)))
 
-		api.WriteJSON(w, http.StatusOK, tenders)
+		api.SetPaginationHeaders(w, r, page.NextToken(), page.Total)
+		api.WriteJSON(w, http.StatusOK, page.Items)
 	}
 }
@@ -16,8 +16,27 @@ func NewGetMyTendersHandlers(log slog.Logger, getUserTendersUseCase usecases.Get
 
 		l := log.With("op", op)
 
-		limit, _ := api.ParseIntQueryParam(r, "limit")
-		offset, _ := api.ParseIntQueryParam(r, "offset")
+		limit, err := api.ParseIntQueryParam(r, "limit")
+		if err != nil {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+			l.Error("invalid limit", sl.Err(err))
+			return
+		}
+
+		offset, err := api.ParseIntQueryParam(r, "offset")
+		if err != nil {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+			l.Error("invalid offset", sl.Err(err))
+			return
+		}
+
+		includeTotal, err := api.ParseBoolQueryParam(r, "include_total")
+		if err != nil {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+			l.Error("invalid include_total", sl.Err(err))
+			return
+		}
+
 		username := api.ParseStringQueryParam(r, "username")
 
 		if username == nil {
@@ -27,14 +46,26 @@ func NewGetMyTendersHandlers(log slog.Logger, getUserTendersUseCase usecases.Get
 		}
 
 		dto := usecases.GetUserTendersDTO{
-			Limit:    limit,
-			Offset:   offset,
-			Username: *username,
+			Limit:          limit,
+			Offset:         offset,
+			Username:       *username,
+			Statuses:       api.ParseStringsQueryParam(r, "status"),
+			ServiceTypes:   api.ParseStringsQueryParam(r, "service_type"),
+			CreatedFrom:    api.ParseStringQueryParam(r, "created_from"),
+			CreatedTo:      api.ParseStringQueryParam(r, "created_to"),
+			UpdatedSince:   api.ParseStringQueryParam(r, "updated_since"),
+			BudgetCurrency: api.ParseStringQueryParam(r, "budget_currency"),
+			MinBudget:      api.ParseStringQueryParam(r, "budget_min"),
+			MaxBudget:      api.ParseStringQueryParam(r, "budget_max"),
+			SortBy:         api.ParseStringQueryParam(r, "sort_by"),
+			SortOrder:      api.ParseStringQueryParam(r, "sort_order"),
+			Cursor:         api.ParseStringQueryParam(r, "cursor"),
+			IncludeTotal:   includeTotal != nil && *includeTotal,
 		}
 
 		l = l.With("dto", dto)
 
-		tenders, err := getUserTendersUseCase.Execute(dto)
+		page, err := getUserTendersUseCase.Execute(dto)
 
 		if err != nil {
 			if errors.Is(errors.Cause(err), domain.ErrValidation) {
@@ -62,11 +93,12 @@ func NewGetMyTendersHandlers(log slog.Logger, getUserTendersUseCase usecases.Get
 				log.Error("user not found", sl.Err(err))
 				return
 			}
-			l.Error("failed to execute getUserTendersUseCase", err.Error())
+			l.Error("failed to execute getUserTendersUseCase", sl.Err(err))
 			api.WriteJSON(w, http.StatusInternalServerError, api.Error("internal server error"))
 			return
 		}
 
-		api.WriteJSON(w, http.StatusOK, tenders)
+		api.SetPaginationHeaders(w, r, page.NextToken(), page.Total)
+		api.WriteJSON(w, http.StatusOK, page.Items)
 	}
 }
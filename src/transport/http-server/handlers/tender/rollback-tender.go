@@ -6,12 +6,13 @@ import (
 	"net/http"
 	"strconv"
 	"tms/src/core/domain"
+	"tms/src/core/services"
 	usecases "tms/src/core/services/use-cases/tender"
 	"tms/src/pkg/api"
 	"tms/src/pkg/logger/sl"
 )
 
-func NewRollbackTenderHandler(logger slog.Logger, rollbackTenderUseCase usecases.RollBackTenderUseCase) http.HandlerFunc {
+func NewRollbackTenderHandler(logger slog.Logger, rollbackTenderUseCase services.UseCase[usecases.RollBackTenderUseCaseDTO, *usecases.RollBackTenderResult]) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		op := "RollbackTenderHandler"
 
@@ -49,15 +50,24 @@ func NewRollbackTenderHandler(logger slog.Logger, rollbackTenderUseCase usecases
 			return
 		}
 
+		dryRun, err := api.ParseBoolQueryParam(r, "dryRun")
+
+		if err != nil {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+			log.Error("invalid dryRun", sl.Err(err))
+			return
+		}
+
 		dto := usecases.RollBackTenderUseCaseDTO{
 			TenderID: tenderID,
 			Version:  version,
 			Username: username,
+			DryRun:   dryRun != nil && *dryRun,
 		}
 
 		log = log.With("dto", dto)
 
-		tender, err := rollbackTenderUseCase.Execute(dto)
+		result, err := rollbackTenderUseCase.Execute(r.Context(), dto)
 
 		if err != nil {
 			if errors.Is(errors.Cause(err), domain.ErrValidation) {
@@ -90,7 +100,13 @@ func NewRollbackTenderHandler(logger slog.Logger, rollbackTenderUseCase usecases
 			return
 		}
 
-		api.WriteJSON(w, http.StatusOK, tender)
+		if dto.DryRun {
+			api.WriteJSON(w, http.StatusOK, result)
+			log.Info("previewed tender rollback")
+			return
+		}
+
+		api.WriteJSON(w, http.StatusOK, result.Tender)
 		log.Info("rolled back tender")
 	}
 }
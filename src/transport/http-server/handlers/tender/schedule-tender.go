@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"github.com/pkg/errors"
+	"log/slog"
+	"net/http"
+	"time"
+	"tms/src/core/domain"
+	"tms/src/core/services"
+	usecases "tms/src/core/services/use-cases/tender"
+	"tms/src/pkg/api"
+	"tms/src/pkg/logger/sl"
+)
+
+type ScheduleTenderHandlerBody struct {
+	PublishAt *time.Time `json:"publishAt"`
+}
+
+func NewScheduleTenderHandler(logger slog.Logger, scheduleTenderUseCase services.UseCase[usecases.ScheduleTenderDTO, *domain.Tender]) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		op := "ScheduleTenderHandler"
+
+		log := logger.With("op", op)
+
+		tenderID := r.PathValue("tenderId")
+
+		if tenderID == "" {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("tenderId is required"))
+			log.Error("tenderId is required")
+			return
+		}
+
+		username := r.URL.Query().Get("username")
+
+		if username == "" {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("username is required"))
+			log.Error("username is required")
+			return
+		}
+
+		body, err := api.ReadJSON[ScheduleTenderHandlerBody](r)
+
+		if err != nil {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("cannot parse body"))
+			log.Error("cannot parse body", sl.Err(err))
+			return
+		}
+
+		dto := usecases.ScheduleTenderDTO{
+			TenderID:  tenderID,
+			Username:  username,
+			PublishAt: body.PublishAt,
+		}
+
+		log = log.With("dto", dto)
+
+		tender, err := scheduleTenderUseCase.Execute(r.Context(), dto)
+
+		if err != nil {
+			if errors.Is(errors.Cause(err), domain.ErrValidation) {
+				api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+				log.Error("validation failed", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrNotFound) {
+				api.WriteJSON(w, http.StatusNotFound, api.Error(err.Error()))
+				log.Error("some entity not found", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrNoPermission) {
+				api.WriteJSON(w, http.StatusForbidden, api.Error(err.Error()))
+				log.Error("permission denied", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrUserNotFound) {
+				api.WriteJSON(w, http.StatusUnauthorized, api.Error(err.Error()))
+				log.Error("user not found", sl.Err(err))
+				return
+			}
+			api.WriteJSON(w, http.StatusInternalServerError, api.Error("internal server error"))
+			log.Error("cannot execute scheduleTenderUseCase", sl.Err(err))
+			return
+		}
+
+		if tender.PublishAt == nil {
+			log.Info("tender publication canceled")
+		} else {
+			log.Info("tender publication scheduled", slog.Time("publishAt", *tender.PublishAt))
+		}
+		api.WriteJSON(w, http.StatusOK, tender)
+	}
+}
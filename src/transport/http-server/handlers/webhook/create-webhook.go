@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"github.com/pkg/errors"
+	"log/slog"
+	"net/http"
+	"tms/src/core/domain"
+	"tms/src/core/services"
+	usecases "tms/src/core/services/use-cases/webhook"
+	"tms/src/pkg/api"
+	"tms/src/pkg/logger/sl"
+)
+
+type CreateWebhookHandlerBody struct {
+	URL    string   `json:"url"`
+	Events []string `json:"events"`
+	Secret string   `json:"secret"`
+}
+
+func NewCreateWebhookHandler(logger slog.Logger, uc services.UseCase[usecases.CreateWebhookDTO, *domain.Webhook]) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		op := "CreateWebhookHandler"
+
+		log := logger.With("op", op)
+
+		username := r.URL.Query().Get("username")
+
+		if username == "" {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("username is required"))
+			log.Error("username is required")
+			return
+		}
+
+		body, err := api.ReadJSON[CreateWebhookHandlerBody](r)
+
+		if err != nil {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("cannot parse body"))
+			log.Error("cannot parse body", sl.Err(err))
+			return
+		}
+
+		dto := usecases.CreateWebhookDTO{
+			Username: username,
+			URL:      body.URL,
+			Events:   body.Events,
+			Secret:   body.Secret,
+		}
+
+		log = log.With("username", username, "url", body.URL, "events", body.Events)
+
+		webhook, err := uc.Execute(r.Context(), dto)
+
+		if err != nil {
+			if errors.Is(errors.Cause(err), domain.ErrValidation) {
+				api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+				log.Error("validation failed", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrNotFound) {
+				api.WriteJSON(w, http.StatusNotFound, api.Error(err.Error()))
+				log.Error("some entity not found", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrNoPermission) {
+				api.WriteJSON(w, http.StatusForbidden, api.Error(err.Error()))
+				log.Error("permission denied", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrUserNotFound) {
+				api.WriteJSON(w, http.StatusUnauthorized, api.Error(err.Error()))
+				log.Error("user not found", sl.Err(err))
+				return
+			}
+			api.WriteJSON(w, http.StatusInternalServerError, api.Error("internal server error"))
+			log.Error("cannot execute createWebhookUseCase", sl.Err(err))
+			return
+		}
+
+		log.Info("webhook created", slog.String("id", string(webhook.ID)))
+		api.WriteJSON(w, http.StatusOK, webhook)
+	}
+}
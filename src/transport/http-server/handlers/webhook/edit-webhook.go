@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"github.com/pkg/errors"
+	"log/slog"
+	"net/http"
+	"tms/src/core/domain"
+	"tms/src/core/services"
+	usecases "tms/src/core/services/use-cases/webhook"
+	"tms/src/pkg/api"
+	"tms/src/pkg/logger/sl"
+)
+
+type EditWebhookHandlerBody struct {
+	URL    *string  `json:"url"`
+	Events []string `json:"events"`
+	Secret *string  `json:"secret"`
+}
+
+func NewEditWebhookHandler(logger slog.Logger, uc services.UseCase[usecases.EditWebhookDTO, *domain.Webhook]) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		op := "EditWebhookHandler"
+
+		log := logger.With("op", op)
+
+		webhookID := r.PathValue("webhookId")
+
+		if webhookID == "" {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("webhookId is required"))
+			log.Error("webhookId is required")
+			return
+		}
+
+		username := r.URL.Query().Get("username")
+
+		if username == "" {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("username is required"))
+			log.Error("username is required")
+			return
+		}
+
+		body, err := api.ReadJSON[EditWebhookHandlerBody](r)
+
+		if err != nil {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("cannot parse body"))
+			log.Error("cannot parse body", sl.Err(err))
+			return
+		}
+
+		dto := usecases.EditWebhookDTO{
+			WebhookID: webhookID,
+			Username:  username,
+			URL:       body.URL,
+			Events:    body.Events,
+			Secret:    body.Secret,
+		}
+
+		log = log.With("webhookId", webhookID, "username", username)
+
+		webhook, err := uc.Execute(r.Context(), dto)
+
+		if err != nil {
+			if errors.Is(errors.Cause(err), domain.ErrValidation) {
+				api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+				log.Error("validation failed", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrNotFound) {
+				api.WriteJSON(w, http.StatusNotFound, api.Error(err.Error()))
+				log.Error("some entity not found", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrNoPermission) {
+				api.WriteJSON(w, http.StatusForbidden, api.Error(err.Error()))
+				log.Error("permission denied", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrUserNotFound) {
+				api.WriteJSON(w, http.StatusUnauthorized, api.Error(err.Error()))
+				log.Error("user not found", sl.Err(err))
+				return
+			}
+			api.WriteJSON(w, http.StatusInternalServerError, api.Error("internal server error"))
+			log.Error("cannot execute editWebhookUseCase", sl.Err(err))
+			return
+		}
+
+		api.WriteJSON(w, http.StatusOK, webhook)
+	}
+}
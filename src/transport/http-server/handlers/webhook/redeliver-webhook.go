@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"github.com/pkg/errors"
+	"log/slog"
+	"net/http"
+	"tms/src/core/domain"
+	"tms/src/core/services"
+	usecases "tms/src/core/services/use-cases/webhook"
+	"tms/src/pkg/api"
+	"tms/src/pkg/logger/sl"
+)
+
+func NewRedeliverWebhookHandler(logger slog.Logger, uc services.UseCase[usecases.RedeliverWebhookDTO, *domain.WebhookDelivery]) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		op := "RedeliverWebhookHandler"
+
+		log := logger.With("op", op)
+
+		webhookID := r.PathValue("webhookId")
+
+		if webhookID == "" {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("webhookId is required"))
+			log.Error("webhookId is required")
+			return
+		}
+
+		deliveryID := r.PathValue("deliveryId")
+
+		if deliveryID == "" {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("deliveryId is required"))
+			log.Error("deliveryId is required")
+			return
+		}
+
+		username := r.URL.Query().Get("username")
+
+		if username == "" {
+			api.WriteJSON(w, http.StatusBadRequest, api.Error("username is required"))
+			log.Error("username is required")
+			return
+		}
+
+		dto := usecases.RedeliverWebhookDTO{
+			WebhookID:  webhookID,
+			DeliveryID: deliveryID,
+			Username:   username,
+		}
+
+		log = log.With("dto", dto)
+
+		delivery, err := uc.Execute(r.Context(), dto)
+
+		if err != nil {
+			if errors.Is(errors.Cause(err), domain.ErrValidation) {
+				api.WriteJSON(w, http.StatusBadRequest, api.Error(err.Error()))
+				log.Error("validation failed", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrNotFound) {
+				api.WriteJSON(w, http.StatusNotFound, api.Error(err.Error()))
+				log.Error("some entity not found", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrNoPermission) {
+				api.WriteJSON(w, http.StatusForbidden, api.Error(err.Error()))
+				log.Error("permission denied", sl.Err(err))
+				return
+			}
+			if errors.Is(errors.Cause(err), domain.ErrUserNotFound) {
+				api.WriteJSON(w, http.StatusUnauthorized, api.Error(err.Error()))
+				log.Error("user not found", sl.Err(err))
+				return
+			}
+			api.WriteJSON(w, http.StatusInternalServerError, api.Error("internal server error"))
+			log.Error("cannot execute redeliverWebhookUseCase", sl.Err(err))
+			return
+		}
+
+		log.Info("webhook delivery requeued")
+		api.WriteJSON(w, http.StatusOK, delivery)
+	}
+}
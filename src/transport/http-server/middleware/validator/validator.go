@@ -0,0 +1,178 @@
+package validator
+
+import (
+	"bytes"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/pkg/errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"tms/src/pkg/api"
+	"tms/src/pkg/logger/sl"
+)
+
+type Config struct {
+	// ValidateResponses включает проверку ответов на соответствие спецификации.
+	// Нарушения только логируются, ответ клиенту не меняется
+	ValidateResponses bool
+}
+
+// New создает middleware, которое проверяет path, query и body запроса по спецификации
+// до вызова обработчика. Запросы на пути, отсутствующие в спецификации, пропускаются без проверки
+func New(log *slog.Logger, doc *openapi3.T, cfg Config) func(next http.Handler) http.Handler {
+	router, err := gorillamux.NewRouter(doc)
+	if err != nil {
+		panic(err)
+	}
+
+	return func(next http.Handler) http.Handler {
+		log := log.With(
+			slog.String("component", "middleware/validator"),
+		)
+
+		log.Info("validator middleware enabled", slog.Bool("validate_responses", cfg.ValidateResponses))
+
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			route, pathParams, err := router.FindRoute(r)
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			input := &openapi3filter.RequestValidationInput{
+				Request:    r,
+				PathParams: pathParams,
+				Route:      route,
+				Options: &openapi3filter.Options{
+					MultiError:          true,
+					SkipSettingDefaults: true,
+					AuthenticationFunc:  openapi3filter.NoopAuthenticationFunc,
+				},
+			}
+
+			if err := openapi3filter.ValidateRequest(r.Context(), input); err != nil {
+				errs := fieldErrors(err)
+				log.Error("request does not match specification",
+					slog.String("request_id", middleware.GetReqID(r.Context())),
+					slog.Any("errors", errs),
+				)
+				api.WriteJSON(w, http.StatusBadRequest, api.ValidationError("request does not match specification", errs))
+				return
+			}
+
+			// Потоковые ответы не буферизуются: они не заканчиваются, пока открыто соединение
+			if !cfg.ValidateResponses || streaming(route) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			bw := &bufferedWriter{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(bw, r)
+
+			err = openapi3filter.ValidateResponse(r.Context(), &openapi3filter.ResponseValidationInput{
+				RequestValidationInput: input,
+				Status:                 bw.status,
+				Header:                 bw.Header(),
+				Body:                   io.NopCloser(bytes.NewReader(bw.body.Bytes())),
+				Options: &openapi3filter.Options{
+					MultiError:            true,
+					IncludeResponseStatus: true,
+				},
+			})
+			if err != nil {
+				log.Error("response does not match specification",
+					slog.String("request_id", middleware.GetReqID(r.Context())),
+					slog.Int("status", bw.status),
+					sl.Err(err),
+				)
+			}
+
+			w.WriteHeader(bw.status)
+			_, _ = w.Write(bw.body.Bytes())
+		}
+
+		return http.HandlerFunc(fn)
+	}
+}
+
+// streaming сообщает, отвечает ли операция потоком text/event-stream или переходом на WebSocket
+func streaming(route *routers.Route) bool {
+	if route.Operation.Responses.Status(http.StatusSwitchingProtocols) != nil {
+		return true
+	}
+	response := route.Operation.Responses.Status(http.StatusOK)
+	return response != nil && response.Value != nil && response.Value.Content.Get("text/event-stream") != nil
+}
+
+// bufferedWriter придерживает ответ обработчика до окончания его проверки
+type bufferedWriter struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *bufferedWriter) WriteHeader(status int) {
+	w.status = status
+}
+
+func (w *bufferedWriter) Write(b []byte) (int, error) {
+	return w.body.Write(b)
+}
+
+// fieldErrors раскладывает ошибку kin-openapi на список нарушений по параметрам
+func fieldErrors(err error) []api.FieldError {
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		errs := make([]api.FieldError, 0, len(e))
+		for _, inner := range e {
+			errs = append(errs, fieldErrors(inner)...)
+		}
+		return errs
+
+	case *openapi3filter.RequestError:
+		in, field := "", ""
+		if e.Parameter != nil {
+			in, field = e.Parameter.In, e.Parameter.Name
+		} else if e.RequestBody != nil {
+			in = "body"
+		}
+
+		if e.Err == nil {
+			return []api.FieldError{{In: in, Field: field, Reason: e.Reason}}
+		}
+
+		if multi, ok := e.Err.(openapi3.MultiError); ok {
+			errs := make([]api.FieldError, 0, len(multi))
+			for _, inner := range multi {
+				errs = append(errs, schemaFieldError(in, field, inner))
+			}
+			return errs
+		}
+
+		return []api.FieldError{schemaFieldError(in, field, e.Err)}
+	}
+
+	return []api.FieldError{{Reason: err.Error()}}
+}
+
+func schemaFieldError(in, field string, err error) api.FieldError {
+	var schemaErr *openapi3.SchemaError
+	if !errors.As(err, &schemaErr) {
+		return api.FieldError{In: in, Field: field, Reason: err.Error()}
+	}
+
+	if pointer := schemaErr.JSONPointer(); len(pointer) > 0 {
+		path := strings.Join(pointer, ".")
+		if field != "" {
+			path = field + "." + path
+		}
+		field = path
+	}
+
+	return api.FieldError{In: in, Field: field, Reason: schemaErr.Reason}
+}
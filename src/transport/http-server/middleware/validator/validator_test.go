@@ -0,0 +1,127 @@
+package validator
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"tms/src/pkg/api"
+	"tms/src/pkg/logger/handlers/slogdiscard"
+	"tms/src/transport/http-server/openapi"
+)
+
+func TestValidator(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	h := New(slogdiscard.NewDiscardLogger(), openapi.MustLoad(), Config{})(next)
+
+	cases := []struct {
+		name   string
+		method string
+		target string
+		host   string
+		body   string
+		status int
+		field  string
+	}{
+		{
+			name:   "valid request",
+			method: http.MethodGet,
+			target: "/api/tenders?limit=10&service_type=Delivery",
+			status: http.StatusOK,
+		},
+		{
+			name:   "limit is not a number",
+			method: http.MethodGet,
+			target: "/api/tenders?limit=abc",
+			status: http.StatusBadRequest,
+			field:  "limit",
+		},
+		{
+			name:   "limit exceeds maximum",
+			method: http.MethodGet,
+			target: "/api/tenders?limit=100",
+			status: http.StatusBadRequest,
+			field:  "limit",
+		},
+		{
+			name:   "request to a non-local host",
+			method: http.MethodGet,
+			target: "/api/tenders?limit=abc",
+			host:   "tms.example.com:443",
+			status: http.StatusBadRequest,
+			field:  "limit",
+		},
+		{
+			name:   "unknown service type",
+			method: http.MethodGet,
+			target: "/api/tenders?service_type=Unknown",
+			status: http.StatusBadRequest,
+			field:  "service_type.0",
+		},
+		{
+			name:   "oversized name",
+			method: http.MethodPost,
+			target: "/api/tenders/new",
+			body: `{"name":"` + strings.Repeat("a", 101) + `","description":"d","serviceType":"Delivery",` +
+				`"organizationId":"org","creatorUsername":"user1"}`,
+			status: http.StatusBadRequest,
+			field:  "name",
+		},
+		{
+			name:   "route missing in specification",
+			method: http.MethodGet,
+			target: "/api/unknown",
+			status: http.StatusOK,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(tc.method, tc.target, strings.NewReader(tc.body))
+			if tc.host != "" {
+				r.Host = tc.host
+			}
+			if tc.body != "" {
+				r.Header.Set("Content-Type", "application/json")
+			}
+			w := httptest.NewRecorder()
+
+			h.ServeHTTP(w, r)
+
+			require.Equal(t, tc.status, w.Code)
+			if tc.field == "" {
+				return
+			}
+
+			var resp api.ValidationErrorResponse
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+			assert.NotEmpty(t, resp.Reason)
+			require.NotEmpty(t, resp.Errors)
+			assert.Equal(t, tc.field, resp.Errors[0].Field)
+		})
+	}
+}
+
+func TestValidatorStreaming(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = w.Write([]byte(": ping\n\n"))
+		require.NoError(t, http.NewResponseController(w).Flush())
+	})
+
+	h := New(slogdiscard.NewDiscardLogger(), openapi.MustLoad(), Config{ValidateResponses: true})(next)
+
+	r := httptest.NewRequest(http.MethodGet, "/api/events/stream?username=user1", nil)
+	w := httptest.NewRecorder()
+
+	h.ServeHTTP(w, r)
+
+	assert.True(t, w.Flushed)
+	assert.Equal(t, ": ping\n\n", w.Body.String())
+}
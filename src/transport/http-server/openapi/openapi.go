@@ -0,0 +1,51 @@
+package openapi
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+//go:embed openapi.yml
+var spec []byte
+
+//go:embed docs.html
+var docs []byte
+
+// Spec возвращает исходный текст спецификации в формате YAML
+func Spec() []byte {
+	return spec
+}
+
+// Docs возвращает HTML страницу интерактивной документации
+func Docs() []byte {
+	return docs
+}
+
+// Load разбирает и валидирует встроенную спецификацию
+func Load() (*openapi3.T, error) {
+	const op = "openapi.Load"
+
+	loader := openapi3.NewLoader()
+
+	doc, err := loader.LoadFromData(spec)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := doc.Validate(context.Background()); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return doc, nil
+}
+
+// MustLoad то же, что и Load, но паникует при ошибке
+func MustLoad() *openapi3.T {
+	doc, err := Load()
+	if err != nil {
+		panic(err)
+	}
+	return doc
+}
@@ -1,12 +1,15 @@
 package http_server
 
 import (
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"log/slog"
 	"net/http"
 	"time"
+	"tms/src/core/services/audit"
 	"tms/src/transport/http-server/middleware/logger"
+	"tms/src/transport/http-server/middleware/validator"
 )
 
 type Config struct {
@@ -14,63 +17,173 @@ type Config struct {
 	ReadTimeout  uint
 	WriteTimeout uint
 	IdleTimeout  uint
+	// Spec спецификация, по которой проверяются запросы
+	Spec *openapi3.T
+	// ValidateResponses проверять ответы по спецификации (для staging)
+	ValidateResponses bool
 }
 
 type Handlers struct {
 	Ping http.HandlerFunc
+	// Documentation handlers
+	OpenAPI http.HandlerFunc
+	Docs    http.HandlerFunc
 	// Tender handlers
-	GetAllTenders      http.HandlerFunc
-	CreateTenders      http.HandlerFunc
-	GetMyTenders       http.HandlerFunc
-	GetTenderStatus    http.HandlerFunc
-	ChangeTenderStatus http.HandlerFunc
-	EditTender         http.HandlerFunc
-	RollbackTender     http.HandlerFunc
+	GetAllTenders          http.HandlerFunc
+	CreateTenders          http.HandlerFunc
+	GetMyTenders           http.HandlerFunc
+	GetTenderStatus        http.HandlerFunc
+	ChangeTenderStatus     http.HandlerFunc
+	GetTenderStatusHistory http.HandlerFunc
+	EditTender             http.HandlerFunc
+	ScheduleTender         http.HandlerFunc
+	GetTenderCriteria      http.HandlerFunc
+	SetTenderCriteria      http.HandlerFunc
+	RollbackTender         http.HandlerFunc
+	GetTenderVersions      http.HandlerFunc
+	GetTenderVersion       http.HandlerFunc
+	GetTenderDiff          http.HandlerFunc
 	// Bid handlers
-	CreateBid       http.HandlerFunc
-	GetUserBid      http.HandlerFunc
-	GetBidsOfTender http.HandlerFunc
-	GetBidStatus    http.HandlerFunc
-	ChangeBidStatus http.HandlerFunc
-	EditBid         http.HandlerFunc
-	SubmitDecision  http.HandlerFunc
-	RollbackBid     http.HandlerFunc
+	CreateBid           http.HandlerFunc
+	GetUserBid          http.HandlerFunc
+	GetBidsOfTender     http.HandlerFunc
+	LiveBidsOfTender    http.HandlerFunc
+	GetBidStatus        http.HandlerFunc
+	ChangeBidStatus     http.HandlerFunc
+	GetBidStatusHistory http.HandlerFunc
+	EditBid             http.HandlerFunc
+	SubmitDecision      http.HandlerFunc
+	SubmitBidScores     http.HandlerFunc
+	RollbackBid         http.HandlerFunc
+	GetBidVersions      http.HandlerFunc
+	GetBidVersion       http.HandlerFunc
+	GetBidDiff          http.HandlerFunc
+	// Audit handlers
+	GetAuditLog http.HandlerFunc
+	// Webhook handlers
+	GetWebhooks          http.HandlerFunc
+	CreateWebhook        http.HandlerFunc
+	EditWebhook          http.HandlerFunc
+	DeleteWebhook        http.HandlerFunc
+	GetWebhookDeliveries http.HandlerFunc
+	RedeliverWebhook     http.HandlerFunc
+	// Notification handlers
+	GetNotifications            http.HandlerFunc
+	GetUnreadNotificationsCount http.HandlerFunc
+	ReadNotification            http.HandlerFunc
+	ReadAllNotifications        http.HandlerFunc
+	GetNotificationPreferences  http.HandlerFunc
+	EditNotificationPreferences http.HandlerFunc
+	// Saved search handlers
+	GetSavedSearches  http.HandlerFunc
+	CreateSavedSearch http.HandlerFunc
+	EditSavedSearch   http.HandlerFunc
+	DeleteSavedSearch http.HandlerFunc
+	// Job handlers
+	GetJobs http.HandlerFunc
+	// Exchange rate handlers
+	GetExchangeRates   http.HandlerFunc
+	CreateExchangeRate http.HandlerFunc
+	DeleteExchangeRate http.HandlerFunc
+	// Event stream handlers
+	StreamEvents http.HandlerFunc
 }
 
 func New(handlers Handlers, log slog.Logger, cfg Config) *http.Server {
+	return &http.Server{
+		Addr:         cfg.Address,
+		Handler:      newRouter(handlers, log, cfg),
+		ReadTimeout:  time.Duration(cfg.ReadTimeout) * time.Second,
+		WriteTimeout: time.Duration(cfg.WriteTimeout) * time.Second,
+		IdleTimeout:  time.Duration(cfg.IdleTimeout) * time.Second,
+	}
+}
+
+func newRouter(handlers Handlers, log slog.Logger, cfg Config) *chi.Mux {
 	router := chi.NewRouter()
 
 	router.Use(middleware.RequestID)
+	router.Use(auditRequestID)
 	router.Use(middleware.Recoverer)
 	router.Use(middleware.URLFormat)
 	router.Use(logger.New(&log))
+	router.Use(validator.New(&log, cfg.Spec, validator.Config{
+		ValidateResponses: cfg.ValidateResponses,
+	}))
 
 	router.Route("/api", func(r chi.Router) {
 		r.Get("/ping", handlers.Ping)
+		// Documentation endpoints: /openapi.json, /openapi.yaml, /docs
+		r.Get("/openapi", handlers.OpenAPI)
+		r.Get("/docs", handlers.Docs)
 		// Tender endpoints
 		r.Get("/tenders", handlers.GetAllTenders)
 		r.Post("/tenders/new", handlers.CreateTenders)
 		r.Get("/tenders/my", handlers.GetMyTenders)
 		r.Get("/tenders/{tenderId}/status", handlers.GetTenderStatus)
 		r.Put("/tenders/{tenderId}/status", handlers.ChangeTenderStatus)
+		r.Get("/tenders/{tenderId}/status/history", handlers.GetTenderStatusHistory)
 		r.Patch("/tenders/{tenderId}/edit", handlers.EditTender)
+		r.Put("/tenders/{tenderId}/schedule", handlers.ScheduleTender)
+		r.Get("/tenders/{tenderId}/criteria", handlers.GetTenderCriteria)
+		r.Put("/tenders/{tenderId}/criteria", handlers.SetTenderCriteria)
 		r.Put("/tenders/{tenderId}/rollback/{version}", handlers.RollbackTender)
+		r.Get("/tenders/{tenderId}/versions", handlers.GetTenderVersions)
+		r.Get("/tenders/{tenderId}/versions/{version}", handlers.GetTenderVersion)
+		r.Get("/tenders/{tenderId}/versions/{version}/diff/{targetVersion}", handlers.GetTenderDiff)
 		// Bid endpoints
 		r.Post("/bids/new", handlers.CreateBid)
-		r.Get("/bids/my", handlers.GetMyTenders)
+		r.Get("/bids/my", handlers.GetUserBid)
 		r.Get("/bids/{tenderId}/list", handlers.GetBidsOfTender)
+		r.Get("/bids/{tenderId}/live", handlers.LiveBidsOfTender)
 		r.Get("/bids/{bidId}/status", handlers.GetBidStatus)
 		r.Put("/bids/{bidId}/status", handlers.ChangeBidStatus)
+		r.Get("/bids/{bidId}/status/history", handlers.GetBidStatusHistory)
 		r.Patch("/bids/{bidId}/edit", handlers.EditBid)
 		r.Put("/bids/{bidId}/submit_decision", handlers.SubmitDecision)
+		r.Put("/bids/{bidId}/scores", handlers.SubmitBidScores)
 		r.Put("/bids/{bidId}/rollback/{version}", handlers.RollbackBid)
+		r.Get("/bids/{bidId}/versions", handlers.GetBidVersions)
+		r.Get("/bids/{bidId}/versions/{version}", handlers.GetBidVersion)
+		r.Get("/bids/{bidId}/versions/{version}/diff/{targetVersion}", handlers.GetBidDiff)
+		// Audit endpoints
+		r.Get("/audit", handlers.GetAuditLog)
+		// Webhook endpoints
+		r.Get("/webhooks", handlers.GetWebhooks)
+		r.Post("/webhooks/new", handlers.CreateWebhook)
+		r.Patch("/webhooks/{webhookId}/edit", handlers.EditWebhook)
+		r.Delete("/webhooks/{webhookId}", handlers.DeleteWebhook)
+		r.Get("/webhooks/{webhookId}/deliveries", handlers.GetWebhookDeliveries)
+		r.Put("/webhooks/{webhookId}/deliveries/{deliveryId}/redeliver", handlers.RedeliverWebhook)
+		// Notification endpoints
+		r.Get("/notifications", handlers.GetNotifications)
+		r.Get("/notifications/unread_count", handlers.GetUnreadNotificationsCount)
+		r.Put("/notifications/read", handlers.ReadAllNotifications)
+		r.Put("/notifications/{notificationId}/read", handlers.ReadNotification)
+		r.Get("/notifications/preferences", handlers.GetNotificationPreferences)
+		r.Patch("/notifications/preferences/edit", handlers.EditNotificationPreferences)
+		// Saved search endpoints
+		r.Get("/saved_searches", handlers.GetSavedSearches)
+		r.Post("/saved_searches/new", handlers.CreateSavedSearch)
+		r.Patch("/saved_searches/{savedSearchId}/edit", handlers.EditSavedSearch)
+		r.Delete("/saved_searches/{savedSearchId}", handlers.DeleteSavedSearch)
+		// Job endpoints
+		r.Get("/jobs", handlers.GetJobs)
+		// Exchange rate endpoints
+		r.Get("/exchange_rates", handlers.GetExchangeRates)
+		r.Post("/exchange_rates/new", handlers.CreateExchangeRate)
+		r.Delete("/exchange_rates/{exchangeRateId}", handlers.DeleteExchangeRate)
+		// Event stream endpoints
+		r.Get("/events/stream", handlers.StreamEvents)
 	})
 
-	return &http.Server{
-		Addr:         cfg.Address,
-		Handler:      router,
-		ReadTimeout:  time.Duration(cfg.ReadTimeout) * time.Second,
-		WriteTimeout: time.Duration(cfg.WriteTimeout) * time.Second,
-		IdleTimeout:  time.Duration(cfg.IdleTimeout) * time.Second,
-	}
+	return router
+}
+
+// auditRequestID передает идентификатор запроса в записи журнала аудита
+func auditRequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := audit.WithRequestID(r.Context(), middleware.GetReqID(r.Context()))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
 }
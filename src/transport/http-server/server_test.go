@@ -0,0 +1,48 @@
+package http_server
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"strings"
+	"testing"
+	"tms/src/pkg/logger/handlers/slogdiscard"
+	"tms/src/transport/http-server/openapi"
+)
+
+// specPaths переводит шаблон маршрута chi в пути спецификации
+func specPaths(route string) []string {
+	path := strings.TrimPrefix(route, "/api")
+	if path == "/openapi" {
+		return []string{"/openapi.json", "/openapi.yaml"}
+	}
+	return []string{path}
+}
+
+func TestRoutesMatchSpec(t *testing.T) {
+	spec := openapi.MustLoad()
+	router := newRouter(Handlers{}, *slogdiscard.NewDiscardLogger(), Config{Spec: spec})
+
+	routed := make(map[string]bool)
+
+	err := chi.Walk(router, func(method string, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		for _, path := range specPaths(route) {
+			routed[method+" "+path] = true
+
+			item := spec.Paths.Find(path)
+			if !assert.NotNil(t, item, "route %s %s is missing in specification", method, path) {
+				continue
+			}
+			assert.NotNil(t, item.GetOperation(method), "operation %s %s is missing in specification", method, path)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	for path, item := range spec.Paths.Map() {
+		for method := range item.Operations() {
+			assert.True(t, routed[method+" "+path], "specification operation %s %s has no route", method, path)
+		}
+	}
+}